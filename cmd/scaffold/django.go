@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// djangoTypes maps Django model field classes onto generator field types.
+var djangoTypes = map[string]string{
+	"AutoField":                 TypeInt,
+	"BigAutoField":              TypeInt,
+	"SmallAutoField":            TypeInt,
+	"IntegerField":              TypeInt,
+	"BigIntegerField":           TypeInt,
+	"SmallIntegerField":         TypeInt,
+	"PositiveIntegerField":      TypeInt,
+	"PositiveBigIntegerField":   TypeInt,
+	"PositiveSmallIntegerField": TypeInt,
+	"CharField":                 TypeString,
+	"TextField":                 TypeString,
+	"SlugField":                 TypeString,
+	"EmailField":                TypeString,
+	"URLField":                  TypeString,
+	"UUIDField":                 TypeString,
+	"BooleanField":              TypeBool,
+	"NullBooleanField":          TypeBool,
+	"FloatField":                TypeFloat,
+	"DecimalField":              TypeDecimal,
+	"DateField":                 TypeDate,
+	"jDateField":                TypeJDate,
+	"DateTimeField":             TypeDateTime,
+	"jDateTimeField":            TypeDateTime,
+	"ForeignKey":                TypeInt,
+	"OneToOneField":             TypeInt,
+}
+
+var (
+	djangoClass = regexp.MustCompile(`^class\s+(\w+)\s*\(([^)]*)\)\s*:`)
+	djangoField = regexp.MustCompile(`^(\w+)\s*=\s*(\w+)\.(\w+)\s*\((.*)\)\s*$`)
+)
+
+// ParseDjango reads the model classes of a Django models.py file. Unknown
+// field classes are reported as errors instead of being dropped.
+func ParseDjango(path string) ([]Entity, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return parseDjango(file, path)
+}
+
+func parseDjango(r io.Reader, name string) ([]Entity, error) {
+	var entities []Entity
+	var current *Entity
+
+	statements, err := djangoStatements(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, st := range statements {
+		if m := djangoClass.FindStringSubmatch(st.text); m != nil && st.indent == 0 {
+			if !strings.Contains(m[2], "Model") {
+				current = nil
+				continue
+			}
+			entities = append(entities, Entity{Name: m[1]})
+			current = &entities[len(entities)-1]
+			continue
+		}
+		if current == nil || st.indent == 0 {
+			current = nil
+			continue
+		}
+
+		m := djangoField.FindStringSubmatch(st.text)
+		if m == nil || (m[2] != "models" && m[2] != "jmodels") {
+			continue
+		}
+		field, err := djangoToField(m[1], m[3], m[4])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %s.%s: %w", name, st.line, current.Name, m[1], err)
+		}
+		if field.References == "self" {
+			field.References = current.Name
+		}
+		current.Fields = append(current.Fields, field)
+	}
+	return entities, nil
+}
+
+func djangoToField(name, class, args string) (Field, error) {
+	fieldType, ok := djangoTypes[class]
+	if !ok {
+		return Field{}, fmt.Errorf("unsupported field class %s", class)
+	}
+	field := Field{Name: name, Type: fieldType}
+
+	positional, kwargs := splitArgs(args)
+	field.Nullable = kwargs["null"] == "True"
+	field.PrimaryKey = kwargs["primary_key"] == "True"
+	field.Index = kwargs["db_index"] == "True"
+	field.Unique = kwargs["unique"] == "True"
+	field.MaxLength, _ = strconv.Atoi(kwargs["max_length"])
+	field.MaxDigits, _ = strconv.Atoi(kwargs["max_digits"])
+	field.Decimals, _ = strconv.Atoi(kwargs["decimal_places"])
+
+	if class == "ForeignKey" || class == "OneToOneField" {
+		target := kwargs["to"]
+		if len(positional) > 0 {
+			target = positional[0]
+		}
+		target = strings.Trim(target, `"'`)
+		if i := strings.LastIndex(target, "."); i >= 0 {
+			target = target[i+1:]
+		}
+		if target == "" {
+			return Field{}, fmt.Errorf("%s without a target model", class)
+		}
+		field.References = target
+		field.Unique = field.Unique || class == "OneToOneField"
+		if !strings.HasSuffix(strings.ToLower(name), "id") {
+			field.Name = name + "Id"
+		}
+	}
+	return field, nil
+}
+
+// splitArgs splits a Python call argument list on top level commas.
+func splitArgs(args string) (positional []string, kwargs map[string]string) {
+	kwargs = map[string]string{}
+	depth := 0
+	var quote rune
+	start := 0
+	var parts []string
+	for i, r := range args {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case r == ')' || r == ']' || r == '}':
+			depth--
+		case r == ',' && depth == 0:
+			parts = append(parts, args[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, args[start:])
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if key, value, ok := strings.Cut(part, "="); ok && isIdentifier(strings.TrimSpace(key)) {
+			kwargs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		} else {
+			positional = append(positional, part)
+		}
+	}
+	return positional, kwargs
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if r != '_' && !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(i > 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+type statement struct {
+	text   string
+	indent int
+	line   int
+}
+
+// djangoStatements joins physical lines into logical Python statements so
+// that field definitions spanning several lines are parsed as one.
+func djangoStatements(r io.Reader) ([]statement, error) {
+	var statements []statement
+	var buf strings.Builder
+	depth, indent, first := 0, 0, 0
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" && depth == 0 {
+			continue
+		}
+		if depth == 0 {
+			indent = len(line) - len(strings.TrimLeft(line, " \t"))
+			first = n
+		}
+		buf.WriteString(strings.TrimSpace(line))
+		buf.WriteString(" ")
+		depth += strings.Count(line, "(") - strings.Count(line, ")")
+		if depth <= 0 {
+			statements = append(statements, statement{text: strings.TrimSpace(buf.String()), indent: indent, line: first})
+			buf.Reset()
+			depth = 0
+		}
+	}
+	return statements, scanner.Err()
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var files = []string{"model.go", "reqres.go", "mapper.go", "repository.go", "service.go", "handler.go", "endpoints.go", "swagger.go"}
+
+// entityView is what the templates render.
+type entityView struct {
+	Entity
+	Module    string
+	HumanName string
+	Fields    []fieldView
+}
+
+type fieldView struct {
+	Field
+	GoName   string
+	JSONName string
+	GoType   string
+	ReqType  string
+	ModelTag string
+	WireTag  string
+
+	// set for foreign keys
+	Association   string
+	AssocJSON     string
+	AssocExpand   string
+	AssocType     string
+	AssocResponse string
+	AssocMapper   string
+	AssocImport   string
+}
+
+func newEntityView(e Entity, module string) entityView {
+	view := entityView{
+		Entity:    e,
+		Module:    module,
+		HumanName: strings.ReplaceAll(e.Package, "_", " "),
+	}
+	for _, f := range e.Fields {
+		view.Fields = append(view.Fields, newFieldView(e, f, module))
+	}
+	return view
+}
+
+func newFieldView(e Entity, f Field, module string) fieldView {
+	v := fieldView{
+		Field:    f,
+		GoName:   pascalCase(f.Name),
+		JSONName: camelCase(f.Name),
+	}
+	base := goType(f.Type)
+	v.GoType = base
+	if f.Nullable {
+		v.GoType = "*" + base
+	}
+	v.ReqType = "*" + base
+	v.WireTag = fmt.Sprintf(`form:"%s" json:"%s"`, v.JSONName, v.JSONName)
+	v.ModelTag = v.WireTag + fmt.Sprintf(` filter:"%s" ordering:"%s"`, v.JSONName, v.JSONName)
+	if gorm := gormTag(f); gorm != "" {
+		v.ModelTag += fmt.Sprintf(` gorm:"%s"`, gorm)
+	}
+
+	if f.References != "" {
+		v.Association = strings.TrimSuffix(strings.TrimSuffix(v.GoName, "Id"), "ID")
+		if v.Association == "" || v.Association == v.GoName {
+			v.Association = f.References
+		}
+		v.AssocJSON = camelCase(v.Association)
+		v.AssocExpand = snakeCase(v.Association)
+		target := snakeCase(f.References)
+		if target == e.Package {
+			v.AssocType = f.References
+			v.AssocResponse = f.References + "Response"
+			v.AssocMapper = "To" + f.References + "Response"
+		} else {
+			v.AssocType = target + "." + f.References
+			v.AssocResponse = target + "." + f.References + "Response"
+			v.AssocMapper = target + ".To" + f.References + "Response"
+			v.AssocImport = module + "/internal/" + target
+		}
+	}
+	return v
+}
+
+func goType(t string) string {
+	switch t {
+	case TypeInt:
+		return "int"
+	case TypeBool:
+		return "bool"
+	case TypeFloat, TypeDecimal:
+		return "float64"
+	case TypeJDate:
+		return "jdate.Date"
+	case TypeDate, TypeDateTime:
+		return "time.Time"
+	default:
+		return "string"
+	}
+}
+
+func gormTag(f Field) string {
+	var parts []string
+	if f.PrimaryKey {
+		return "primary_key"
+	}
+	switch {
+	case f.Type == TypeDecimal && f.MaxDigits > 0:
+		parts = append(parts, fmt.Sprintf("type:numeric(%d,%d)", f.MaxDigits, f.Decimals))
+	case f.Type == TypeDate || f.Type == TypeJDate:
+		parts = append(parts, "type:date")
+	case f.Type == TypeString && f.MaxLength > 0:
+		parts = append(parts, fmt.Sprintf("size:%d", f.MaxLength))
+	}
+	if f.notNull() {
+		parts = append(parts, "not null")
+	}
+	if f.Unique {
+		parts = append(parts, "uniqueIndex")
+	} else if f.Index || f.References != "" {
+		parts = append(parts, "index")
+	}
+	return strings.Join(parts, ";")
+}
+
+// notNull reports whether the column of f is NOT NULL. The zero jdate.Date
+// is written as NULL, so jdate columns stay nullable for every field.
+func (f Field) notNull() bool {
+	return !f.Nullable && f.Type != TypeJDate
+}
+
+func (v entityView) HasReferences() bool {
+	for _, f := range v.Fields {
+		if f.Association != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandKeys lists the ?expand= keys of the entity for its docs.
+func (v entityView) ExpandKeys() string {
+	var keys []string
+	for _, f := range v.Fields {
+		if f.Association != "" {
+			keys = append(keys, f.AssocExpand)
+		}
+	}
+	return strings.Join(keys, ", ")
+}
+
+func (v entityView) hasType(t string) bool {
+	for _, f := range v.Fields {
+		if f.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (v entityView) ForeignImports() []string {
+	seen := map[string]bool{}
+	var imports []string
+	for _, f := range v.Fields {
+		if f.AssocImport != "" && !seen[f.AssocImport] {
+			seen[f.AssocImport] = true
+			imports = append(imports, f.AssocImport)
+		}
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+func (v entityView) ModelImports() []string {
+	imports := v.ForeignImports()
+	if v.hasType(TypeJDate) {
+		imports = append(imports, v.Module+"/pkg/jdate")
+	}
+	if v.hasType(TypeDate) || v.hasType(TypeDateTime) {
+		imports = append(imports, "time")
+	}
+	return imports
+}
+
+func (v entityView) ReqresImports() []string {
+	return v.ModelImports()
+}
+
+// Generate writes the package of one entity below outDir.
+func Generate(view entityView, outDir string, force bool) error {
+	dir := filepath.Join(outDir, view.Package)
+	if _, err := os.Stat(dir); err == nil && !force {
+		return fmt.Errorf("%s already exists, use -force to overwrite", dir)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	names := files
+	if view.HasReferences() {
+		names = append(names, "references.go")
+	}
+	for _, name := range names {
+		var buf bytes.Buffer
+		if err := templates.ExecuteTemplate(&buf, name, view); err != nil {
+			return fmt.Errorf("%s/%s: %w", view.Package, name, err)
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("%s/%s: generated invalid Go: %w\n%s", view.Package, name, err, buf.String())
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), src, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Command scaffold generates a CRUD module under internal/ from a YAML/JSON
+// entity spec or a Django models.py file, adds a migration creating its
+// table and registers its routes in main.go.
+//
+//	go run ./cmd/scaffold -spec offer.yaml
+//	go run ./cmd/scaffold -django models.py -only Offer,Broker
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	specPath := flag.String("spec", "", "YAML or JSON entity spec")
+	djangoPath := flag.String("django", "", "Django models.py to convert")
+	only := flag.String("only", "", "comma separated entity names to generate, default all")
+	outDir := flag.String("out", "internal", "directory the packages are written to")
+	routerFile := flag.String("router", "main.go", "file holding setupRoutes")
+	migrationsDir := flag.String("migrations", "migrations", "directory the table migrations are written to")
+	register := flag.Bool("register", true, "register the generated modules in the router file")
+	force := flag.Bool("force", false, "overwrite existing packages")
+	flag.Parse()
+
+	log.SetFlags(0)
+	log.SetPrefix("scaffold: ")
+
+	var entities []Entity
+	var err error
+	switch {
+	case *specPath != "" && *djangoPath != "":
+		log.Fatal("use either -spec or -django")
+	case *specPath != "":
+		entities, err = LoadSpec(*specPath)
+	case *djangoPath != "":
+		entities, err = ParseDjango(*djangoPath)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	entities = filter(entities, *only)
+	if len(entities) == 0 {
+		log.Fatal("no entities to generate")
+	}
+
+	module, err := modulePath("go.mod")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for i := range entities {
+		if err := entities[i].Normalize(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := checkReferences(entities, *outDir); err != nil {
+		log.Fatal(err)
+	}
+	entities = dependencyOrder(entities)
+
+	for _, e := range entities {
+		view := newEntityView(e, module)
+		if err := Generate(view, *outDir, *force); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("generated %s/%s\n", *outDir, e.Package)
+
+		migration, err := WriteMigration(view, *migrationsDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("generated %s\n", migration)
+
+		if *register {
+			added, err := Register(view, *routerFile)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if added {
+				fmt.Printf("registered %s at %s\n", e.Package, e.Route)
+			}
+		}
+	}
+}
+
+func filter(entities []Entity, only string) []Entity {
+	if only == "" {
+		return entities
+	}
+	wanted := map[string]bool{}
+	for _, name := range strings.Split(only, ",") {
+		wanted[strings.TrimSpace(name)] = true
+	}
+	var out []Entity
+	for _, e := range entities {
+		if wanted[e.Name] {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// checkReferences makes sure every foreign key target is either generated in
+// this run or already exists as a package.
+func checkReferences(entities []Entity, outDir string) error {
+	generated := map[string]bool{}
+	for _, e := range entities {
+		generated[e.Name] = true
+	}
+	for _, e := range entities {
+		for _, f := range e.Fields {
+			if f.References == "" || generated[f.References] {
+				continue
+			}
+			if _, err := os.Stat(outDir + "/" + snakeCase(f.References)); err != nil {
+				return fmt.Errorf("%s.%s references %s, which is neither generated nor present in %s", e.Name, f.Name, f.References, outDir)
+			}
+		}
+	}
+	return nil
+}
+
+// dependencyOrder puts referenced entities before the ones pointing at them,
+// so their migrations create the tables in a valid order.
+func dependencyOrder(entities []Entity) []Entity {
+	byName := map[string]Entity{}
+	for _, e := range entities {
+		byName[e.Name] = e
+	}
+	done := map[string]bool{}
+	var ordered []Entity
+	var visit func(e Entity)
+	visit = func(e Entity) {
+		if done[e.Name] {
+			return
+		}
+		done[e.Name] = true
+		for _, f := range e.Fields {
+			if target, ok := byName[f.References]; ok {
+				visit(target)
+			}
+		}
+		ordered = append(ordered, e)
+	}
+	for _, e := range entities {
+		visit(e)
+	}
+	return ordered
+}
+
+func modulePath(goMod string) (string, error) {
+	file, err := os.Open(goMod)
+	if err != nil {
+		return "", fmt.Errorf("run scaffold from the module root: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.TrimSpace(module), nil
+		}
+	}
+	return "", fmt.Errorf("%s: no module directive", goMod)
+}
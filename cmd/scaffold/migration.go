@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"ibrokers_service/pkg/migrate"
+	"strings"
+
+	"gorm.io/gorm/schema"
+)
+
+// naming is the strategy gorm uses for the models, so the migration creates
+// the table and column names the generated code expects.
+var naming = schema.NamingStrategy{}
+
+// WriteMigration adds a migration creating the table of an entity to dir.
+func WriteMigration(view entityView, dir string) (string, error) {
+	up, down := migrationSQL(view)
+	path, _, err := migrate.Create(dir, "create_"+naming.TableName(view.Name), up, down)
+	return path, err
+}
+
+func migrationSQL(view entityView) (up, down string) {
+	table := naming.TableName(view.Name)
+	var columns, constraints, indexes []string
+	for _, f := range view.Fields {
+		column := naming.ColumnName(table, f.GoName)
+		columns = append(columns, fmt.Sprintf("    %s %s", column, sqlType(f)))
+
+		if f.Association != "" {
+			constraints = append(constraints, fmt.Sprintf(
+				"    CONSTRAINT fk_%s_%s FOREIGN KEY (%s) REFERENCES %s (id) ON UPDATE CASCADE ON DELETE RESTRICT",
+				table, naming.ColumnName(table, f.Association), column, naming.TableName(f.References)))
+		}
+		switch {
+		case f.PrimaryKey:
+		case f.Unique:
+			indexes = append(indexes, fmt.Sprintf("CREATE UNIQUE INDEX idx_%s_%s ON %s (%s);\n", table, column, table, column))
+		case f.Index || f.References != "":
+			indexes = append(indexes, fmt.Sprintf("CREATE INDEX idx_%s_%s ON %s (%s);\n", table, column, table, column))
+		}
+	}
+
+	up = fmt.Sprintf("CREATE TABLE %s (\n%s\n);\n", table, strings.Join(append(columns, constraints...), ",\n"))
+	if len(indexes) > 0 {
+		up += "\n" + strings.Join(indexes, "")
+	}
+	down = fmt.Sprintf("DROP TABLE IF EXISTS %s;\n", table)
+	return up, down
+}
+
+// sqlType follows the Postgres types gorm would pick for the model field.
+func sqlType(f fieldView) string {
+	if f.PrimaryKey {
+		return "bigserial PRIMARY KEY"
+	}
+	var t string
+	switch f.Type {
+	case TypeInt:
+		t = "bigint"
+	case TypeBool:
+		t = "boolean"
+	case TypeFloat:
+		t = "decimal"
+	case TypeDecimal:
+		t = "decimal"
+		if f.MaxDigits > 0 {
+			t = fmt.Sprintf("numeric(%d,%d)", f.MaxDigits, f.Decimals)
+		}
+	case TypeDate, TypeJDate:
+		t = "date"
+	case TypeDateTime:
+		t = "timestamptz"
+	default:
+		t = "text"
+		if f.MaxLength > 0 {
+			t = fmt.Sprintf("varchar(%d)", f.MaxLength)
+		}
+	}
+	if f.notNull() {
+		t += " NOT NULL"
+	}
+	return t
+}
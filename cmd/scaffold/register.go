@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+)
+
+const routesMarker = "// scaffold:routes"
+
+// Register adds the import and the route block of an entity to the router
+// file. Entities that are already imported are left alone.
+func Register(view entityView, routerFile string) (bool, error) {
+	data, err := os.ReadFile(routerFile)
+	if err != nil {
+		return false, err
+	}
+	src := string(data)
+
+	importPath := fmt.Sprintf("%q", view.Module+"/internal/"+view.Package)
+	if strings.Contains(src, importPath) {
+		return false, nil
+	}
+	if !strings.Contains(src, routesMarker) {
+		return false, fmt.Errorf("%s: marker %q not found", routerFile, routesMarker)
+	}
+
+	src, err = addImport(src, importPath, view.Module+"/internal/")
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", routerFile, err)
+	}
+
+	var route bytes.Buffer
+	if err := templates.ExecuteTemplate(&route, "route", view); err != nil {
+		return false, err
+	}
+	src = insertBefore(src, routesMarker, strings.TrimPrefix(route.String(), "\t")+"\t")
+
+	out, err := format.Source([]byte(src))
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", routerFile, err)
+	}
+	return true, os.WriteFile(routerFile, out, 0o644)
+}
+
+// addImport places path after the last import sharing prefix so the
+// internal packages stay grouped together.
+func addImport(src, path, prefix string) (string, error) {
+	start := strings.Index(src, "import (")
+	if start < 0 {
+		return "", fmt.Errorf("no import block")
+	}
+	end := start + strings.Index(src[start:], "\n)")
+
+	lines := strings.Split(src[start:end], "\n")
+	insertAt := len(lines)
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, `"`+prefix) {
+			insertAt = i + 1
+			if trimmed > path {
+				insertAt = i
+				break
+			}
+		}
+	}
+	lines = append(lines[:insertAt], append([]string{"\t" + path}, lines[insertAt:]...)...)
+	return src[:start] + strings.Join(lines, "\n") + src[end:], nil
+}
+
+func insertBefore(src, marker, text string) string {
+	i := strings.Index(src, marker)
+	return src[:i] + text + src[i:]
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Field types understood by the generator.
+const (
+	TypeString   = "string"
+	TypeInt      = "int"
+	TypeBool     = "bool"
+	TypeFloat    = "float"
+	TypeDecimal  = "decimal"
+	TypeDate     = "date"
+	TypeJDate    = "jdate"
+	TypeDateTime = "datetime"
+)
+
+var fieldTypes = []string{TypeString, TypeInt, TypeBool, TypeFloat, TypeDecimal, TypeDate, TypeJDate, TypeDateTime}
+
+// Entity is one resource to generate. It is what a YAML/JSON spec file
+// contains and what the Django parser produces.
+type Entity struct {
+	Name    string  `json:"name" yaml:"name"`
+	Package string  `json:"package" yaml:"package"`
+	Route   string  `json:"route" yaml:"route"`
+	Fields  []Field `json:"fields" yaml:"fields"`
+}
+
+type Field struct {
+	Name       string `json:"name" yaml:"name"`
+	Type       string `json:"type" yaml:"type"`
+	Nullable   bool   `json:"nullable" yaml:"nullable"`
+	PrimaryKey bool   `json:"primary_key" yaml:"primary_key"`
+	Index      bool   `json:"index" yaml:"index"`
+	Unique     bool   `json:"unique" yaml:"unique"`
+	MaxLength  int    `json:"max_length" yaml:"max_length"`
+	MaxDigits  int    `json:"max_digits" yaml:"max_digits"`
+	Decimals   int    `json:"decimal_places" yaml:"decimal_places"`
+	// References names the entity a foreign key points at, e.g. "Broker".
+	References string `json:"references" yaml:"references"`
+}
+
+// spec files hold either a single entity or a list under "entities".
+type specFile struct {
+	Entity   `yaml:",inline"`
+	Entities []Entity `json:"entities" yaml:"entities"`
+}
+
+func LoadSpec(path string) ([]Entity, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec specFile
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, &spec)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &spec)
+	default:
+		return nil, fmt.Errorf("%s: unsupported spec format, use .yaml, .yml or .json", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if spec.Entities != nil {
+		return spec.Entities, nil
+	}
+	return []Entity{spec.Entity}, nil
+}
+
+// Normalize fills in defaults and rejects specs the generator cannot honour.
+func (e *Entity) Normalize() error {
+	if e.Name == "" {
+		return fmt.Errorf("entity without a name")
+	}
+	if e.Package == "" {
+		e.Package = snakeCase(e.Name)
+	}
+	if e.Route == "" {
+		e.Route = "/" + strings.ReplaceAll(e.Package, "_", "-")
+	}
+
+	hasPrimaryKey := false
+	for i := range e.Fields {
+		f := &e.Fields[i]
+		if f.Name == "" {
+			return fmt.Errorf("%s: field without a name", e.Name)
+		}
+		if f.References != "" && f.Type == "" {
+			f.Type = TypeInt
+		}
+		if !validType(f.Type) {
+			return fmt.Errorf("%s.%s: unknown type %q, expected one of %s", e.Name, f.Name, f.Type, strings.Join(fieldTypes, ", "))
+		}
+		if f.PrimaryKey {
+			if f.Name != "id" || f.Type != TypeInt {
+				return fmt.Errorf("%s.%s: the primary key must be an int field named id", e.Name, f.Name)
+			}
+			f.Nullable = false
+			hasPrimaryKey = true
+		}
+		if f.References != "" && f.Type != TypeInt {
+			return fmt.Errorf("%s.%s: foreign keys must be int", e.Name, f.Name)
+		}
+	}
+	if !hasPrimaryKey {
+		e.Fields = append([]Field{{Name: "id", Type: TypeInt, PrimaryKey: true}}, e.Fields...)
+	}
+	return nil
+}
+
+func validType(t string) bool {
+	for _, known := range fieldTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+var wordBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+func snakeCase(s string) string {
+	return strings.ToLower(wordBoundary.ReplaceAllString(s, "${1}_${2}"))
+}
+
+func pascalCase(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if r == '_' || r == '-' {
+			upper = true
+			continue
+		}
+		if upper {
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func camelCase(s string) string {
+	p := pascalCase(s)
+	if p == "" {
+		return p
+	}
+	r := []rune(p)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
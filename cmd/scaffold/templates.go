@@ -0,0 +1,236 @@
+package main
+
+import "text/template"
+
+var templates = template.Must(template.New("scaffold").Parse(`
+{{define "model.go"}}package {{.Package}}
+{{with .ModelImports}}
+import (
+{{range .}}	"{{.}}"
+{{end}})
+{{end}}
+type {{.Name}} struct {
+{{range .Fields}}	{{.GoName}} {{.GoType}} ` + "`{{.ModelTag}}`" + `
+{{if .Association}}	{{.Association}} *{{.AssocType}} ` + "`" + `form:"-" json:"-" gorm:"foreignKey:{{.GoName}};constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"` + "`" + `
+{{end}}{{end}}}
+{{end}}
+
+{{define "reqres.go"}}package {{.Package}}
+{{with .ReqresImports}}
+import (
+{{range .}}	"{{.}}"
+{{end}})
+{{end}}
+type Create{{.Name}}Request struct {
+{{range .Fields}}	{{.GoName}} {{.ReqType}} ` + "`{{.WireTag}}`" + `
+{{end}}}
+
+type {{.Name}}Response struct {
+{{range .Fields}}	{{.GoName}} {{.ReqType}} ` + "`{{.WireTag}}`" + `
+{{if .Association}}	{{.Association}} *{{.AssocResponse}} ` + "`" + `json:"{{.AssocJSON}},omitempty"` + "`" + `
+{{end}}{{end}}}
+{{end}}
+
+{{define "mapper.go"}}package {{.Package}}
+{{with .ForeignImports}}
+import (
+{{range .}}	"{{.}}"
+{{end}})
+{{end}}
+func To{{.Name}}Response(item {{.Name}}) {{.Name}}Response {
+	response := {{.Name}}Response{
+{{range .Fields}}		{{.GoName}}: {{if .Nullable}}item.{{.GoName}}{{else}}&item.{{.GoName}}{{end}},
+{{end}}	}
+{{range .Fields}}{{if .Association}}	if item.{{.Association}} != nil {
+		expanded := {{.AssocMapper}}(*item.{{.Association}})
+		response.{{.Association}} = &expanded
+	}
+{{end}}{{end}}	return response
+}
+{{end}}
+
+{{define "references.go"}}package {{.Package}}
+
+import (
+{{range .ForeignImports}}	"{{.}}"
+{{end}}	"{{.Module}}/pkg/crud"
+)
+
+var references = []crud.Reference[{{.Name}}]{
+{{range .Fields}}{{if .Association}}	{
+		Field:       "{{.JSONName}}",
+		Expand:      "{{.AssocExpand}}",
+		Association: "{{.Association}}",
+		Model:       &{{.AssocType}}{},
+		Id:          func(item {{$.Name}}) *int { return {{if .Nullable}}item.{{.GoName}}{{else}}&item.{{.GoName}}{{end}} },
+	},
+{{end}}{{end}}}
+{{end}}
+
+{{define "repository.go"}}package {{.Package}}
+
+import "{{.Module}}/pkg/crud"
+
+type Repository = crud.Repository[{{.Name}}]
+{{end}}
+
+{{define "service.go"}}package {{.Package}}
+
+import "{{.Module}}/pkg/crud"
+
+type Service = crud.Service[{{.Name}}]
+{{if .HasReferences}}
+func NewService(rep Repository) Service {
+	return Service{
+		Repository: rep,
+		Validate:   crud.CheckReferences(rep, references),
+	}
+}
+{{end}}{{end}}
+
+{{define "handler.go"}}package {{.Package}}
+
+import "{{.Module}}/pkg/crud"
+
+const BucketName = "{{.Package}}"
+
+var Err{{.Name}}NotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[{{.Name}}, Create{{.Name}}Request, {{.Name}}Response]
+{{end}}
+
+{{define "endpoints.go"}}package {{.Package}}
+
+import (
+	"{{.Module}}/pkg/crud"
+	"{{.Module}}/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[{{.Name}}, Create{{.Name}}Request, {{.Name}}Response]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "{{.HumanName}}",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  To{{.Name}}Response,
+{{if .HasReferences}}		Expand:      crud.ExpandMap(references),
+{{end}}	})
+}
+{{end}}
+
+{{define "swagger.go"}}package {{.Package}}
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// {{.HumanName}} routes live here; make docs reads them with --parseFuncBody.
+
+// List{{.Name}} godoc
+// @Summary      List {{.HumanName}}
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         {{.HumanName}}
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]{{.Name}}Response}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       {{.Route}}/api/v1/ [get]
+
+// Search{{.Name}} godoc
+// @Summary      Search {{.HumanName}}
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         {{.HumanName}}
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]{{.Name}}Response}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       {{.Route}}/api/v1/search/ [post]
+
+// Get{{.Name}} godoc
+// @Summary      Get {{.HumanName}} by id
+// @Tags         {{.HumanName}}
+// @Produce      json
+// @Param        id      path   int     true   "{{.Name}} id"
+{{- if .HasReferences}}
+// @Param        expand  query  string  false  "Comma separated associations to embed: {{.ExpandKeys}}"
+{{- end}}
+// @Success      200  {object}  {{.Name}}Response
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       {{.Route}}/api/v1/{id}/ [get]
+
+// Create{{.Name}} godoc
+// @Summary      Create {{.HumanName}}
+// @Tags         {{.HumanName}}
+// @Accept       json
+// @Produce      json
+// @Param        body  body  Create{{.Name}}Request  true  "{{.Name}}"
+// @Success      201  {object}  {{.Name}}Response
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       {{.Route}}/api/v1/ [post]
+
+// Replace{{.Name}} godoc
+// @Summary      Replace {{.HumanName}}
+// @Description  Fields left out of the body are cleared.
+// @Tags         {{.HumanName}}
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "{{.Name}} id"
+// @Param        body  body  Create{{.Name}}Request  true  "{{.Name}}"
+// @Success      200  {object}  {{.Name}}Response
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       {{.Route}}/api/v1/{id}/ [put]
+
+// Update{{.Name}} godoc
+// @Summary      Update {{.HumanName}} fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         {{.HumanName}}
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "{{.Name}} id"
+// @Param        body  body  Create{{.Name}}Request  true  "Fields to change"
+// @Success      200  {object}  {{.Name}}Response
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       {{.Route}}/api/v1/{id}/ [patch]
+
+// Delete{{.Name}} godoc
+// @Summary      Delete {{.HumanName}}
+// @Tags         {{.HumanName}}
+// @Param        id  path  int  true  "{{.Name}} id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       {{.Route}}/api/v1/{id}/ [delete]
+{{end}}
+
+{{define "route"}}	{
+		rep := {{.Package}}.Repository{DB: db}
+		srv := {{if .HasReferences}}{{.Package}}.NewService(rep){{else}}{{.Package}}.Service{Repository: rep}{{end}}
+		{{.Package}}.CreateEndpoint(srv, router.Group("{{.Route}}"), *fileManager).WithAccess(access.Reference).V1()
+	}
+{{end}}
+`))
@@ -0,0 +1,59 @@
+package api_key
+
+import (
+	"ibrokers_service/pkg/crud"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Endpoints mounts the key management routes, guarded like a crud.Resource:
+// Access.Read before listing and showing keys, Access.Write before issuing,
+// rotating and revoking them.
+type Endpoints struct {
+	Router  *gin.RouterGroup
+	Handler *Handler
+	Access  crud.Access
+}
+
+func CreateEndpoint(s Service, router *gin.RouterGroup) *Endpoints {
+	return &Endpoints{
+		Router: router,
+		Handler: &Handler{
+			Handler: crud.Handler[APIKey, IssueAPIKeyRequest, APIKeyResponse]{
+				Name:       "api key",
+				Service:    crud.Service[APIKey]{Repository: s.Repository.Repository},
+				ToResponse: ToAPIKeyResponse,
+			},
+			Keys: s,
+		},
+	}
+}
+
+// WithAccess sets the guards V1 puts in front of the routes.
+func (e *Endpoints) WithAccess(access crud.Access) *Endpoints {
+	e.Access = access
+	return e
+}
+
+func (e *Endpoints) V1() {
+	groupV1 := e.Router.Group("/api/v1")
+	read := guarded(e.Access.Read)
+	write := guarded(e.Access.Write)
+	{
+		groupV1.GET("/", read(e.Handler.List)...)
+		groupV1.POST("/", write(e.Handler.Issue)...)
+		groupV1.POST("/search/", read(e.Handler.Search)...)
+		groupV1.GET("/:id/", read(e.Handler.Details)...)
+		groupV1.POST("/:id/rotate/", write(e.Handler.Rotate)...)
+		groupV1.DELETE("/:id/", write(e.Handler.Revoke)...)
+	}
+}
+
+func guarded(guard gin.HandlerFunc) func(gin.HandlerFunc) []gin.HandlerFunc {
+	return func(handler gin.HandlerFunc) []gin.HandlerFunc {
+		if guard == nil {
+			return []gin.HandlerFunc{handler}
+		}
+		return []gin.HandlerFunc{guard, handler}
+	}
+}
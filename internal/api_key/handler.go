@@ -0,0 +1,109 @@
+package api_key
+
+import (
+	"errors"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/crud"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler lists and shows keys like every resource and adds issuing,
+// rotating and revoking them. Keys are never updated or deleted otherwise,
+// so the records keep who could call the API and when.
+type Handler struct {
+	crud.Handler[APIKey, IssueAPIKeyRequest, APIKeyResponse]
+	Keys Service
+}
+
+// Issue godoc
+// @Summary      Issue api key
+// @Description  The secret is only returned in this response.
+// @Tags         api key
+// @Accept       json
+// @Produce      json
+// @Param        body  body  IssueAPIKeyRequest  true  "Key"
+// @Success      201  {object}  IssuedAPIKeyResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /api-key/api/v1/ [post]
+func (h *Handler) Issue(ctx *gin.Context) {
+	var req IssueAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		apperror.Write(ctx, apperror.ErrInvalidBody.WithDetail("%s", err))
+		return
+	}
+	key, secret, err := h.Keys.Issue(ctx.Request.Context(), req)
+	if err != nil {
+		apperror.Write(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, ToIssuedAPIKeyResponse(key, secret))
+}
+
+// Rotate godoc
+// @Summary      Rotate api key
+// @Description  Replaces the secret of the key; the old one stops working at once.
+// @Tags         api key
+// @Produce      json
+// @Param        id  path  int  true  "APIKey id"
+// @Success      200  {object}  IssuedAPIKeyResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /api-key/api/v1/{id}/rotate/ [post]
+func (h *Handler) Rotate(ctx *gin.Context) {
+	id, ok := h.id(ctx)
+	if !ok {
+		return
+	}
+	key, secret, err := h.Keys.Rotate(ctx.Request.Context(), id)
+	if err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, ToIssuedAPIKeyResponse(key, secret))
+}
+
+// Revoke godoc
+// @Summary      Revoke api key
+// @Description  The key is kept with its revocation time.
+// @Tags         api key
+// @Produce      json
+// @Param        id  path  int  true  "APIKey id"
+// @Success      200  {object}  APIKeyResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /api-key/api/v1/{id}/ [delete]
+func (h *Handler) Revoke(ctx *gin.Context) {
+	id, ok := h.id(ctx)
+	if !ok {
+		return
+	}
+	key, err := h.Keys.Revoke(ctx.Request.Context(), id)
+	if err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, ToAPIKeyResponse(key))
+}
+
+func (h *Handler) id(ctx *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		apperror.Write(ctx, apperror.ErrInvalidID.WithDetail("%q is not an id", ctx.Param("id")))
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *Handler) writeError(ctx *gin.Context, err error) {
+	if errors.Is(err, crud.ErrNotFound) {
+		err = crud.ErrNotFound.WithDetail("%s %s not found", h.Name, ctx.Param("id"))
+	}
+	apperror.Write(ctx, err)
+}
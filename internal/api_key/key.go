@@ -0,0 +1,48 @@
+package api_key
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// keyPrefix starts every key, so leaked keys are easy to find by scanners.
+const keyPrefix = "ibk_"
+
+// generate returns a new key, ibk_<prefix>_<secret>, its prefix and the
+// hash to store. The prefix has 64 random bits and the secret 256.
+func generate() (key, prefix, hash string, err error) {
+	id := make([]byte, 8)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", "", err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+	prefix = hex.EncodeToString(id)
+	key = keyPrefix + prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	return key, prefix, hashKey(key), nil
+}
+
+// parse returns the prefix of key, false when key is not shaped like one.
+func parse(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, keyPrefix)
+	if !ok {
+		return "", false
+	}
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(prefix) != 16 || secret == "" {
+		return "", false
+	}
+	return prefix, true
+}
+
+// hashKey is SHA-256, enough for random keys of 256 bits, which nobody can
+// guess from the hash and which have to be checked on every request.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
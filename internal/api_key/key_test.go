@@ -0,0 +1,54 @@
+package api_key
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	key, prefix, hash, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(key, keyPrefix+prefix+"_") {
+		t.Errorf("generate() key %q does not start with %q", key, keyPrefix+prefix+"_")
+	}
+	if got, ok := parse(key); !ok || got != prefix {
+		t.Errorf("parse(generate()) = %q, %v, want %q", got, ok, prefix)
+	}
+	if hash != hashKey(key) || hash == key {
+		t.Errorf("generate() hash = %q, want the hash of the key", hash)
+	}
+
+	other, otherPrefix, _, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == key || otherPrefix == prefix {
+		t.Error("generate() returned the same key twice")
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		key        string
+		wantPrefix string
+		wantOK     bool
+	}{
+		{"ibk_0123456789abcdef_c2VjcmV0", "0123456789abcdef", true},
+		{"ibk_0123456789abcdef_with_underscores", "0123456789abcdef", true},
+		{"", "", false},
+		{"0123456789abcdef_c2VjcmV0", "", false},
+		{"xyz_0123456789abcdef_c2VjcmV0", "", false},
+		{"ibk_0123456789abcdef", "", false},
+		{"ibk_0123456789abcdef_", "", false},
+		{"ibk_0123456789abcde_c2VjcmV0", "", false},
+		{"ibk_0123456789abcdef0_c2VjcmV0", "", false},
+	}
+	for _, tt := range tests {
+		prefix, ok := parse(tt.key)
+		if prefix != tt.wantPrefix || ok != tt.wantOK {
+			t.Errorf("parse(%q) = %q, %v, want %q, %v", tt.key, prefix, ok, tt.wantPrefix, tt.wantOK)
+		}
+	}
+}
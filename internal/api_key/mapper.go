@@ -0,0 +1,21 @@
+package api_key
+
+import "strings"
+
+func ToAPIKeyResponse(key APIKey) APIKeyResponse {
+	return APIKeyResponse{
+		Id:         key.Id,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     strings.Fields(key.Scopes),
+		BrokerId:   key.BrokerId,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+func ToIssuedAPIKeyResponse(key APIKey, secret string) IssuedAPIKeyResponse {
+	return IssuedAPIKeyResponse{APIKeyResponse: ToAPIKeyResponse(key), Key: secret}
+}
@@ -0,0 +1,55 @@
+package api_key
+
+import (
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/auth"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const Header = "X-API-Key"
+
+// touchInterval bounds how often a key's last used time is written, so a
+// busy script does not update its row on every call.
+const touchInterval = time.Minute
+
+// Authenticate reads the X-API-Key header of every request. A valid key
+// authenticates the request like a bearer token, as api_key:<id> with its
+// scopes as permissions, so auth.Require enforces scopes per route; an
+// invalid one is rejected by the routes that require a permission.
+func Authenticate(keys Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		secret := strings.TrimSpace(c.GetHeader(Header))
+		if secret == "" {
+			c.Next()
+			return
+		}
+		ctx := c.Request.Context()
+		key, err := keys.Verify(ctx, secret)
+		if rejected(err) {
+			auth.Reject(c, err)
+			c.Next()
+			return
+		} else if err != nil {
+			// the key could not be checked, like when the database is down
+			apperror.Write(c, err)
+			return
+		}
+		auth.SetPrincipal(c, auth.Principal{
+			Subject:     "api_key:" + strconv.Itoa(key.Id),
+			Permissions: strings.Fields(key.Scopes),
+		})
+
+		now := time.Now()
+		if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= touchInterval {
+			if err := keys.Repository.Touch(ctx, key.Id, now); err != nil {
+				log.Printf("api key %d: last used time: %v", key.Id, err)
+			}
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,95 @@
+package api_key
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"ibrokers_service/pkg/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAuthenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s := newTestService(t)
+	past := time.Now().Add(-time.Hour)
+	reader, readerSecret := insert(t, s, nil)
+	_, writerSecret := insert(t, s, func(k *APIKey) { k.Scopes = "reference:read reference:write" })
+	_, revokedSecret := insert(t, s, func(k *APIKey) { k.RevokedAt = &past })
+
+	app := gin.New()
+	app.Use(Authenticate(s))
+	subject := func(c *gin.Context) { c.String(http.StatusOK, c.GetString(auth.UserIDKey)) }
+	app.GET("/open", subject)
+	app.GET("/read", auth.Require(auth.PermReferenceRead), subject)
+	app.GET("/write", auth.Require(auth.PermReferenceWrite), subject)
+
+	tests := []struct {
+		name   string
+		path   string
+		key    string
+		status int
+		body   string
+	}{
+		{name: "no key", path: "/open", status: http.StatusOK, body: ""},
+		{name: "no key on a guarded route", path: "/read", status: http.StatusUnauthorized},
+		{name: "scoped", path: "/read", key: readerSecret, status: http.StatusOK, body: "api_key:" + strconv.Itoa(reader.Id)},
+		{name: "spaces around the key", path: "/read", key: " " + readerSecret + " ", status: http.StatusOK, body: "api_key:" + strconv.Itoa(reader.Id)},
+		{name: "missing scope", path: "/write", key: readerSecret, status: http.StatusForbidden},
+		{name: "second scope", path: "/write", key: writerSecret, status: http.StatusOK},
+		{name: "malformed", path: "/read", key: "secret", status: http.StatusUnauthorized},
+		{name: "revoked", path: "/read", key: revokedSecret, status: http.StatusUnauthorized},
+		{name: "invalid key on an open route", path: "/open", key: "secret", status: http.StatusOK, body: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.key != "" {
+				req.Header.Set(Header, tt.key)
+			}
+			w := httptest.NewRecorder()
+			app.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.status == http.StatusOK && tt.body != "" && w.Body.String() != tt.body {
+				t.Errorf("body = %q, want %q", w.Body, tt.body)
+			}
+		})
+	}
+
+	stored, err := s.Repository.FindById(context.Background(), reader.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.LastUsedAt == nil {
+		t.Error("the last used time of a key in use was not set")
+	}
+}
+
+func TestAuthenticateStoreDown(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	s := newTestService(t)
+	_, secret := insert(t, s, nil)
+	sqlDB, err := s.Repository.DB.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.Close()
+
+	app := gin.New()
+	app.Use(Authenticate(s))
+	app.GET("/open", func(c *gin.Context) { c.Status(http.StatusOK) })
+	req := httptest.NewRequest(http.MethodGet, "/open", nil)
+	req.Header.Set(Header, secret)
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, req)
+	// a key that cannot be checked is neither trusted nor ignored
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+}
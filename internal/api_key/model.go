@@ -0,0 +1,24 @@
+package api_key
+
+import "time"
+
+// APIKey lets a partner broker's scripts call the API with the X-API-Key
+// header. Only the SHA-256 of the key is kept; Prefix is its public part
+// and finds the row.
+type APIKey struct {
+	Id         int        `json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Name       string     `json:"name" filter:"name" ordering:"name"`
+	Prefix     string     `json:"prefix" filter:"prefix"`
+	SecretHash string     `json:"-"`
+	Scopes     string     `json:"scopes"`
+	BrokerId   int        `json:"brokerId" filter:"brokerId" ordering:"brokerId"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt" ordering:"createdAt"`
+}
+
+// Active reports whether the key is neither revoked nor expired at now.
+func (k APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
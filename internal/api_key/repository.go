@@ -0,0 +1,66 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/metrics"
+	"ibrokers_service/pkg/tracing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	crud.Repository[APIKey]
+}
+
+func NewRepository(db *gorm.DB) Repository {
+	return Repository{crud.Repository[APIKey]{DB: db}}
+}
+
+// FindByPrefix loads the key with the given public prefix. A missing row is
+// reported as crud.ErrNotFound.
+func (r *Repository) FindByPrefix(ctx context.Context, prefix string) (_ APIKey, err error) {
+	ctx, span := tracing.Start(ctx, "api_key.Repository.FindByPrefix")
+	defer func() { tracing.End(span, err) }()
+
+	var key APIKey
+	result := r.DB.WithContext(metrics.WithMethod(ctx, "FindByPrefix")).Where("prefix = ?", prefix).First(&key)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return key, crud.ErrNotFound
+	}
+	return key, result.Error
+}
+
+// Touch sets the last used time of a key without loading or saving the
+// rest of the row.
+func (r *Repository) Touch(ctx context.Context, id int, at time.Time) (err error) {
+	ctx, span := tracing.Start(ctx, "api_key.Repository.Touch")
+	defer func() { tracing.End(span, err) }()
+
+	return r.DB.WithContext(metrics.WithMethod(ctx, "Touch")).Model(&APIKey{}).Where("id = ?", id).Update("last_used_at", at).Error
+}
+
+// Rotate stores a new prefix and hash for a key that is not revoked, in one
+// conditional update so a revoke committed meanwhile is never undone. It
+// reports whether the key was still active.
+func (r *Repository) Rotate(ctx context.Context, id int, prefix, hash string) (_ bool, err error) {
+	ctx, span := tracing.Start(ctx, "api_key.Repository.Rotate")
+	defer func() { tracing.End(span, err) }()
+
+	result := r.DB.WithContext(metrics.WithMethod(ctx, "Rotate")).Model(&APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]any{"prefix": prefix, "secret_hash": hash, "last_used_at": nil})
+	return result.RowsAffected > 0, result.Error
+}
+
+// Revoke sets the revoked time of a key unless it has one, leaving the rest
+// of the row, like a key rotated meanwhile, as it is.
+func (r *Repository) Revoke(ctx context.Context, id int, at time.Time) (err error) {
+	ctx, span := tracing.Start(ctx, "api_key.Repository.Revoke")
+	defer func() { tracing.End(span, err) }()
+
+	return r.DB.WithContext(metrics.WithMethod(ctx, "Revoke")).Model(&APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).Update("revoked_at", at).Error
+}
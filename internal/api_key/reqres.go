@@ -0,0 +1,30 @@
+package api_key
+
+import "time"
+
+type IssueAPIKeyRequest struct {
+	Name     string   `json:"name"`
+	BrokerId int      `json:"brokerId"`
+	Scopes   []string `json:"scopes"`
+	// ExpiresAt is optional, keys without it stay valid until revoked.
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+type APIKeyResponse struct {
+	Id         int        `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	BrokerId   int        `json:"brokerId"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+// IssuedAPIKeyResponse carries the key itself, which is only ever shown in
+// the responses of issuing and rotating it.
+type IssuedAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
@@ -0,0 +1,197 @@
+package api_key
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"ibrokers_service/internal/broker"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/auth"
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/tracing"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Scopes are the permissions a key can be issued with. Admin is left out,
+// so keys cannot manage keys.
+var Scopes = []string{auth.PermReferenceRead, auth.PermReferenceWrite, auth.PermOfferPublish}
+
+var ErrAPIKeyRevoked = apperror.ErrAPIKeyRevoked
+
+// Reasons Verify rejects a key with.
+var (
+	errMalformed = errors.New("api key is malformed")
+	errUnknown   = errors.New("api key is unknown")
+	errRevoked   = errors.New("api key is revoked")
+	errExpired   = errors.New("api key is expired")
+)
+
+// rejected reports whether err is one of the reasons Verify rejects a key
+// with, rather than a failure to check it.
+func rejected(err error) bool {
+	return errors.Is(err, errMalformed) || errors.Is(err, errUnknown) || errors.Is(err, errRevoked) || errors.Is(err, errExpired)
+}
+
+type Service struct {
+	Repository Repository
+}
+
+// Issue creates a key for a broker and returns it with the key itself,
+// which is not stored and cannot be shown again.
+func (s *Service) Issue(ctx context.Context, req IssueAPIKeyRequest) (_ APIKey, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "api_key.Service.Issue")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.validate(ctx, req); err != nil {
+		return APIKey{}, "", err
+	}
+	secret, prefix, hash, err := generate()
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	scopes := slices.Clone(req.Scopes)
+	slices.Sort(scopes)
+	key, err := s.Repository.Create(ctx, APIKey{
+		Name:       strings.TrimSpace(req.Name),
+		Prefix:     prefix,
+		SecretHash: hash,
+		Scopes:     strings.Join(slices.Compact(scopes), " "),
+		BrokerId:   req.BrokerId,
+		ExpiresAt:  req.ExpiresAt,
+		CreatedAt:  time.Now(),
+	})
+	return key, secret, err
+}
+
+// Rotate replaces the key of a record, keeping its name, scopes, broker and
+// expiry. The old key stops working at once.
+func (s *Service) Rotate(ctx context.Context, id int) (_ APIKey, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "api_key.Service.Rotate")
+	defer func() { tracing.End(span, err) }()
+
+	key, err := s.Repository.FindById(ctx, id)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	if key.RevokedAt != nil {
+		return APIKey{}, "", ErrAPIKeyRevoked.WithDetail("api key %d was revoked at %s", id, key.RevokedAt.Format(time.RFC3339))
+	}
+	secret, prefix, hash, err := generate()
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	rotated, err := s.Repository.Rotate(ctx, id, prefix, hash)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	if !rotated {
+		// revoked since it was read
+		return APIKey{}, "", ErrAPIKeyRevoked.WithDetail("api key %d was revoked", id)
+	}
+	key.Prefix, key.SecretHash, key.LastUsedAt = prefix, hash, nil
+	return key, secret, nil
+}
+
+// Revoke disables a key for good. Revoking it again changes nothing.
+func (s *Service) Revoke(ctx context.Context, id int) (_ APIKey, err error) {
+	ctx, span := tracing.Start(ctx, "api_key.Service.Revoke")
+	defer func() { tracing.End(span, err) }()
+
+	key, err := s.Repository.FindById(ctx, id)
+	if err != nil || key.RevokedAt != nil {
+		return key, err
+	}
+	if err := s.Repository.Revoke(ctx, id, time.Now()); err != nil {
+		return APIKey{}, err
+	}
+	// reread, the key may have been revoked or rotated meanwhile
+	return s.Repository.FindById(ctx, id)
+}
+
+// Verify returns the record of an active key. Keys that are malformed,
+// unknown, revoked or expired are rejected with an error saying which.
+func (s *Service) Verify(ctx context.Context, secret string) (_ APIKey, err error) {
+	ctx, span := tracing.Start(ctx, "api_key.Service.Verify")
+	defer func() { tracing.End(span, err) }()
+
+	prefix, ok := parse(secret)
+	if !ok {
+		return APIKey{}, errMalformed
+	}
+	key, err := s.Repository.FindByPrefix(ctx, prefix)
+	if errors.Is(err, crud.ErrNotFound) {
+		return APIKey{}, errUnknown
+	} else if err != nil {
+		return APIKey{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashKey(secret)), []byte(key.SecretHash)) != 1 {
+		return APIKey{}, errUnknown
+	}
+	switch {
+	case key.RevokedAt != nil:
+		return APIKey{}, errRevoked
+	case !key.Active(time.Now()):
+		return APIKey{}, errExpired
+	}
+	return key, nil
+}
+
+// validate names every rejected field of an issue request.
+func (s *Service) validate(ctx context.Context, req IssueAPIKeyRequest) error {
+	var fields []apperror.Field
+	if strings.TrimSpace(req.Name) == "" {
+		fields = append(fields, apperror.Field{
+			Name:    "name",
+			Code:    "required",
+			Message: apperror.Message{En: "is required", Fa: "این فیلد الزامی است."},
+		})
+	}
+	if len(req.Scopes) == 0 {
+		fields = append(fields, apperror.Field{
+			Name:    "scopes",
+			Code:    "required",
+			Message: apperror.Message{En: "at least one scope is required", Fa: "دست‌کم یک دامنه دسترسی لازم است."},
+		})
+	}
+	for _, scope := range req.Scopes {
+		if !slices.Contains(Scopes, scope) {
+			fields = append(fields, apperror.Field{
+				Name: "scopes",
+				Code: "invalid_scope",
+				Message: apperror.Message{
+					En: fmt.Sprintf("unknown scope %q, expected one of %s", scope, strings.Join(Scopes, ", ")),
+					Fa: fmt.Sprintf("دامنه دسترسی %q معتبر نیست.", scope),
+				},
+			})
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		fields = append(fields, apperror.Field{
+			Name:    "expiresAt",
+			Code:    "in_past",
+			Message: apperror.Message{En: "must be in the future", Fa: "باید در آینده باشد."},
+		})
+	}
+	if len(fields) > 0 {
+		return crud.ErrValidation.WithFields(fields...)
+	}
+
+	exists, err := s.Repository.Exists(ctx, &broker.Broker{}, req.BrokerId)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return crud.ErrInvalidReference.WithFields(apperror.Field{
+			Name: "brokerId",
+			Code: crud.ErrInvalidReference.Code,
+			Message: apperror.Message{
+				En: fmt.Sprintf("no record with id %d", req.BrokerId),
+				Fa: fmt.Sprintf("رکوردی با شناسه %d وجود ندارد.", req.BrokerId),
+			},
+		})
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ibrokers_service/pkg/apperror"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newTestService(t *testing.T) Service {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every connection would open a database of its own
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := db.AutoMigrate(&APIKey{}); err != nil {
+		t.Fatal(err)
+	}
+	return Service{Repository: NewRepository(db)}
+}
+
+// insert stores a key with the reference:read scope, changed by edit, and
+// returns it with its secret.
+func insert(t *testing.T, s Service, edit func(*APIKey)) (APIKey, string) {
+	t.Helper()
+	secret, prefix, hash, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := APIKey{Name: "script", Prefix: prefix, SecretHash: hash, Scopes: "reference:read", BrokerId: 1, CreatedAt: time.Now()}
+	if edit != nil {
+		edit(&key)
+	}
+	key, err = s.Repository.Create(context.Background(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, secret
+}
+
+func TestVerify(t *testing.T) {
+	s := newTestService(t)
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+	active, activeSecret := insert(t, s, nil)
+	_, expiringSecret := insert(t, s, func(k *APIKey) { k.ExpiresAt = &future })
+	_, revokedSecret := insert(t, s, func(k *APIKey) { k.RevokedAt = &past })
+	_, expiredSecret := insert(t, s, func(k *APIKey) { k.ExpiresAt = &past })
+	unknownSecret, _, _, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the prefix of an existing key with another secret
+	forged := activeSecret[:len(keyPrefix)+17] + "forged"
+
+	tests := []struct {
+		name    string
+		secret  string
+		wantId  int
+		wantErr error
+	}{
+		{name: "active", secret: activeSecret, wantId: active.Id},
+		{name: "expiring later", secret: expiringSecret, wantId: active.Id + 1},
+		{name: "malformed", secret: "not-a-key", wantErr: errMalformed},
+		{name: "unknown", secret: unknownSecret, wantErr: errUnknown},
+		{name: "wrong secret", secret: forged, wantErr: errUnknown},
+		{name: "revoked", secret: revokedSecret, wantErr: errRevoked},
+		{name: "expired", secret: expiredSecret, wantErr: errExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := s.Verify(context.Background(), tt.secret)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) || !rejected(err) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if key.Id != tt.wantId {
+				t.Errorf("Verify() = key %d, want %d", key.Id, tt.wantId)
+			}
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	key, oldSecret := insert(t, s, nil)
+
+	rotated, newSecret, err := s.Rotate(ctx, key.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.Prefix == key.Prefix || newSecret == oldSecret {
+		t.Fatal("Rotate() kept the old key")
+	}
+	if _, err := s.Verify(ctx, oldSecret); !errors.Is(err, errUnknown) {
+		t.Errorf("Verify(old key) error = %v, want %v", err, errUnknown)
+	}
+	if got, err := s.Verify(ctx, newSecret); err != nil || got.Id != key.Id || got.Name != key.Name || got.Scopes != key.Scopes {
+		t.Errorf("Verify(new key) = %+v, %v, want key %d with its name and scopes", got, err, key.Id)
+	}
+
+	if _, err := s.Revoke(ctx, key.Id); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := s.Rotate(ctx, key.Id); !errors.Is(err, apperror.ErrAPIKeyRevoked) {
+		t.Errorf("Rotate(revoked) error = %v, want %v", err, apperror.ErrAPIKeyRevoked)
+	}
+	if _, _, err := s.Rotate(ctx, key.Id+1); !errors.Is(err, apperror.ErrNotFound) {
+		t.Errorf("Rotate(missing) error = %v, want %v", err, apperror.ErrNotFound)
+	}
+}
+
+// A revoke that commits after Rotate read the key must stay.
+func TestRotateAfterConcurrentRevoke(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	key, secret := insert(t, s, nil)
+
+	if err := s.Repository.Revoke(ctx, key.Id, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	_, prefix, hash, err := generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated, err := s.Repository.Rotate(ctx, key.Id, prefix, hash)
+	if err != nil || rotated {
+		t.Fatalf("Repository.Rotate(revoked) = %v, %v, want false", rotated, err)
+	}
+	stored, err := s.Repository.FindById(ctx, key.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.RevokedAt == nil || stored.Prefix != key.Prefix {
+		t.Errorf("stored key = %+v, want it revoked with its old prefix", stored)
+	}
+	if _, err := s.Verify(ctx, secret); !errors.Is(err, errRevoked) {
+		t.Errorf("Verify() error = %v, want %v", err, errRevoked)
+	}
+}
+
+func TestRevoke(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t)
+	key, _ := insert(t, s, nil)
+
+	revoked, err := s.Revoke(ctx, key.Id)
+	if err != nil || revoked.RevokedAt == nil {
+		t.Fatalf("Revoke() = %+v, %v, want it revoked", revoked, err)
+	}
+	again, err := s.Revoke(ctx, key.Id)
+	if err != nil || again.RevokedAt == nil || !again.RevokedAt.Equal(*revoked.RevokedAt) {
+		t.Errorf("Revoke() again = %v, %v, want the first revoked time %v", again.RevokedAt, err, revoked.RevokedAt)
+	}
+}
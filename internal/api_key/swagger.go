@@ -0,0 +1,51 @@
+package api_key
+
+// List, Search and Details come from the embedded crud.Handler, so their
+// swag annotations live here; make docs reads them with --parseFuncBody.
+
+// ListAPIKey godoc
+// @Summary      List api key
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         api key
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]APIKeyResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /api-key/api/v1/ [get]
+
+// SearchAPIKey godoc
+// @Summary      Search api key
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         api key
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]APIKeyResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /api-key/api/v1/search/ [post]
+
+// GetAPIKey godoc
+// @Summary      Get api key by id
+// @Tags         api key
+// @Produce      json
+// @Param        id      path   int     true   "APIKey id"
+// @Success      200  {object}  APIKeyResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /api-key/api/v1/{id}/ [get]
@@ -1,15 +1,12 @@
 package broker
 
-
-
-
-func ToBrokerResponse(buyMethod Broker) BrokerResponse {
-    return BrokerResponse {
-		Id: &buyMethod.Id,
-		Description: &buyMethod.Description,
-		Persianname: &buyMethod.Persianname,
-		Spotid: &buyMethod.Spotid,
-		Derivativesid: &buyMethod.Derivativesid,
-		Nationalid: &buyMethod.Nationalid,
-    }
+func ToBrokerResponse(broker Broker) BrokerResponse {
+	return BrokerResponse{
+		Id:            &broker.Id,
+		Description:   &broker.Description,
+		Persianname:   &broker.Persianname,
+		Spotid:        &broker.Spotid,
+		Derivativesid: &broker.Derivativesid,
+		Nationalid:    &broker.Nationalid,
+	}
 }
@@ -1,10 +1,10 @@
 package broker
 
 type Broker struct {
-    Id            int    `form:"id" gorm:"primary_key"`
-    Description string `form:"description"`
-    Persianname string `form:"persianName"`
-    Spotid int `form:"spotId"`
-    Derivativesid int `form:"derivativesId"`
-    Nationalid string `form:"nationalId"`
+	Id            int    `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description   string `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname   string `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+	Spotid        int    `form:"spotId" json:"spotId" filter:"spotId" ordering:"spotId"`
+	Derivativesid int    `form:"derivativesId" json:"derivativesId" filter:"derivativesId" ordering:"derivativesId"`
+	Nationalid    string `form:"nationalId" json:"nationalId" filter:"nationalId" ordering:"nationalId"`
 }
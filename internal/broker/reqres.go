@@ -1,21 +1,19 @@
 package broker
 
-
-
 type CreateBrokerRequest struct {
-	Id *int `form:"id"`
-	Description *string `form:"description"`
-	Persianname *string `form:"persianName"`
-	Spotid *int `form:"spotId"`
-	Derivativesid *int `form:"derivativesId"`
-	Nationalid *string `form:"nationalId"`
+	Id            *int    `form:"id" json:"id"`
+	Description   *string `form:"description" json:"description"`
+	Persianname   *string `form:"persianName" json:"persianName"`
+	Spotid        *int    `form:"spotId" json:"spotId"`
+	Derivativesid *int    `form:"derivativesId" json:"derivativesId"`
+	Nationalid    *string `form:"nationalId" json:"nationalId"`
 }
 
 type BrokerResponse struct {
-    	Id *int `form:"id"`
-	Description *string `form:"description"`
-	Persianname *string `form:"persianName"`
-	Spotid *int `form:"spotId"`
-	Derivativesid *int `form:"derivativesId"`
-	Nationalid *string `form:"nationalId"`
+	Id            *int    `form:"id" json:"id"`
+	Description   *string `form:"description" json:"description"`
+	Persianname   *string `form:"persianName" json:"persianName"`
+	Spotid        *int    `form:"spotId" json:"spotId"`
+	Derivativesid *int    `form:"derivativesId" json:"derivativesId"`
+	Nationalid    *string `form:"nationalId" json:"nationalId"`
 }
@@ -0,0 +1,101 @@
+package broker
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// broker routes live here; make docs reads them with --parseFuncBody.
+
+// ListBroker godoc
+// @Summary      List broker
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         broker
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]BrokerResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /broker/api/v1/ [get]
+
+// SearchBroker godoc
+// @Summary      Search broker
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         broker
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]BrokerResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /broker/api/v1/search/ [post]
+
+// GetBroker godoc
+// @Summary      Get broker by id
+// @Tags         broker
+// @Produce      json
+// @Param        id      path   int     true   "Broker id"
+// @Success      200  {object}  BrokerResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /broker/api/v1/{id}/ [get]
+
+// CreateBroker godoc
+// @Summary      Create broker
+// @Tags         broker
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateBrokerRequest  true  "Broker"
+// @Success      201  {object}  BrokerResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /broker/api/v1/ [post]
+
+// ReplaceBroker godoc
+// @Summary      Replace broker
+// @Description  Fields left out of the body are cleared.
+// @Tags         broker
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Broker id"
+// @Param        body  body  CreateBrokerRequest  true  "Broker"
+// @Success      200  {object}  BrokerResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /broker/api/v1/{id}/ [put]
+
+// UpdateBroker godoc
+// @Summary      Update broker fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         broker
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Broker id"
+// @Param        body  body  CreateBrokerRequest  true  "Fields to change"
+// @Success      200  {object}  BrokerResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /broker/api/v1/{id}/ [patch]
+
+// DeleteBroker godoc
+// @Summary      Delete broker
+// @Tags         broker
+// @Param        id  path  int  true  "Broker id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /broker/api/v1/{id}/ [delete]
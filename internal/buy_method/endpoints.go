@@ -1,30 +1,19 @@
 package buy_method
 
 import (
-    "github.com/gin-gonic/gin"
-    "ibrokers_service/pkg/utils/manager"
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
 )
 
-type Endpoints struct {
-    Router      *gin.RouterGroup
-    BuyMethodHandler Handler
-}
+type Endpoints = crud.Resource[BuyMethod, CreateBuyMethodRequest, BuyMethodResponse]
 
 func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
-    return &Endpoints{
-        Router:      router,
-        BuyMethodHandler: Handler{Service: s, FileManager: fileManager},
-    }
-}
-
-func (e *Endpoints) V1() {
-    groupV1 := e.Router.Group("/api/v1")
-    {
-        groupV1.GET("/", e.BuyMethodHandler.GetBuyMethod)
-        groupV1.POST("/", e.BuyMethodHandler.CreateBuyMethod)
-        groupV1.GET("/:id/", e.BuyMethodHandler.GetBuyMethodDetails)
-        groupV1.PUT("/:id/", e.BuyMethodHandler.UpdateBuyMethod)
-        groupV1.PATCH("/:id/", e.BuyMethodHandler.UpdateBuyMethodPartial)
-        groupV1.DELETE("/:id/", e.BuyMethodHandler.DeleteBuyMethod)
-    }
+	return crud.NewResource(router, Handler{
+		Name:        "buy method",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToBuyMethodResponse,
+	})
 }
@@ -1,7 +1,7 @@
 package buy_method
 
 type BuyMethod struct {
-    Id            int    `form:"id" gorm:"primary_key"`
-    Description string `form:"description"`
-    Persianname string `form:"persianName"`
+	Id          int    `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description string `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname string `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
 }
@@ -1,15 +1,13 @@
 package buy_method
 
-
-
 type CreateBuyMethodRequest struct {
-	Id *int `form:"id"`
-	Description *string `form:"description"`
-	Persianname *string `form:"persianName"`
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
 }
 
 type BuyMethodResponse struct {
-    	Id *int `form:"id"`
-	Description *string `form:"description"`
-	Persianname *string `form:"persianName"`
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
 }
@@ -0,0 +1,101 @@
+package buy_method
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// buy method routes live here; make docs reads them with --parseFuncBody.
+
+// ListBuyMethod godoc
+// @Summary      List buy method
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         buy method
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]BuyMethodResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /buy-method/api/v1/ [get]
+
+// SearchBuyMethod godoc
+// @Summary      Search buy method
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         buy method
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]BuyMethodResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /buy-method/api/v1/search/ [post]
+
+// GetBuyMethod godoc
+// @Summary      Get buy method by id
+// @Tags         buy method
+// @Produce      json
+// @Param        id      path   int     true   "BuyMethod id"
+// @Success      200  {object}  BuyMethodResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /buy-method/api/v1/{id}/ [get]
+
+// CreateBuyMethod godoc
+// @Summary      Create buy method
+// @Tags         buy method
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateBuyMethodRequest  true  "BuyMethod"
+// @Success      201  {object}  BuyMethodResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /buy-method/api/v1/ [post]
+
+// ReplaceBuyMethod godoc
+// @Summary      Replace buy method
+// @Description  Fields left out of the body are cleared.
+// @Tags         buy method
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "BuyMethod id"
+// @Param        body  body  CreateBuyMethodRequest  true  "BuyMethod"
+// @Success      200  {object}  BuyMethodResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /buy-method/api/v1/{id}/ [put]
+
+// UpdateBuyMethod godoc
+// @Summary      Update buy method fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         buy method
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "BuyMethod id"
+// @Param        body  body  CreateBuyMethodRequest  true  "Fields to change"
+// @Success      200  {object}  BuyMethodResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /buy-method/api/v1/{id}/ [patch]
+
+// DeleteBuyMethod godoc
+// @Summary      Delete buy method
+// @Tags         buy method
+// @Param        id  path  int  true  "BuyMethod id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /buy-method/api/v1/{id}/ [delete]
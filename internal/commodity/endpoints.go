@@ -0,0 +1,20 @@
+package commodity
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[Commodity, CreateCommodityRequest, CommodityResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "commodity",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToCommodityResponse,
+		Expand:      crud.ExpandMap(references),
+	})
+}
@@ -0,0 +1,9 @@
+package commodity
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "commodity"
+
+var ErrCommodityNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[Commodity, CreateCommodityRequest, CommodityResponse]
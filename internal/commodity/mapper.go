@@ -0,0 +1,19 @@
+package commodity
+
+import (
+	"ibrokers_service/internal/sub_group"
+)
+
+func ToCommodityResponse(commodity Commodity) CommodityResponse {
+	response := CommodityResponse{
+		Id:          &commodity.Id,
+		Description: &commodity.Description,
+		Persianname: &commodity.Persianname,
+		Subgroupid:  commodity.Subgroupid,
+	}
+	if commodity.SubGroup != nil {
+		expanded := sub_group.ToSubGroupResponse(*commodity.SubGroup)
+		response.SubGroup = &expanded
+	}
+	return response
+}
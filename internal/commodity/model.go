@@ -0,0 +1,13 @@
+package commodity
+
+import (
+	"ibrokers_service/internal/sub_group"
+)
+
+type Commodity struct {
+	Id          int                 `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description string              `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname string              `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+	Subgroupid  *int                `form:"subGroupId" json:"subGroupId" filter:"subGroupId" ordering:"subGroupId" gorm:"index"`
+	SubGroup    *sub_group.SubGroup `form:"-" json:"-" gorm:"foreignKey:Subgroupid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
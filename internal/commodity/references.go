@@ -0,0 +1,16 @@
+package commodity
+
+import (
+	"ibrokers_service/internal/sub_group"
+	"ibrokers_service/pkg/crud"
+)
+
+var references = []crud.Reference[Commodity]{
+	{
+		Field:       "subGroupId",
+		Expand:      "sub_group",
+		Association: "SubGroup",
+		Model:       &sub_group.SubGroup{},
+		Id:          func(c Commodity) *int { return c.Subgroupid },
+	},
+}
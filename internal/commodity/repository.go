@@ -0,0 +1,5 @@
+package commodity
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[Commodity]
@@ -0,0 +1,20 @@
+package commodity
+
+import (
+	"ibrokers_service/internal/sub_group"
+)
+
+type CreateCommodityRequest struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+	Subgroupid  *int    `form:"subGroupId" json:"subGroupId"`
+}
+
+type CommodityResponse struct {
+	Id          *int                        `form:"id" json:"id"`
+	Description *string                     `form:"description" json:"description"`
+	Persianname *string                     `form:"persianName" json:"persianName"`
+	Subgroupid  *int                        `form:"subGroupId" json:"subGroupId"`
+	SubGroup    *sub_group.SubGroupResponse `json:"subGroup,omitempty"`
+}
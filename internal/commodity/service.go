@@ -0,0 +1,12 @@
+package commodity
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[Commodity]
+
+func NewService(rep Repository) Service {
+	return Service{
+		Repository: rep,
+		Validate:   crud.CheckReferences(rep, references),
+	}
+}
@@ -0,0 +1,102 @@
+package commodity
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// commodity routes live here; make docs reads them with --parseFuncBody.
+
+// ListCommodity godoc
+// @Summary      List commodity
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         commodity
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]CommodityResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /commodity/api/v1/ [get]
+
+// SearchCommodity godoc
+// @Summary      Search commodity
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         commodity
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]CommodityResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /commodity/api/v1/search/ [post]
+
+// GetCommodity godoc
+// @Summary      Get commodity by id
+// @Tags         commodity
+// @Produce      json
+// @Param        id      path   int     true   "Commodity id"
+// @Param        expand  query  string  false  "Comma separated associations to embed: sub_group"
+// @Success      200  {object}  CommodityResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /commodity/api/v1/{id}/ [get]
+
+// CreateCommodity godoc
+// @Summary      Create commodity
+// @Tags         commodity
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateCommodityRequest  true  "Commodity"
+// @Success      201  {object}  CommodityResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /commodity/api/v1/ [post]
+
+// ReplaceCommodity godoc
+// @Summary      Replace commodity
+// @Description  Fields left out of the body are cleared.
+// @Tags         commodity
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Commodity id"
+// @Param        body  body  CreateCommodityRequest  true  "Commodity"
+// @Success      200  {object}  CommodityResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /commodity/api/v1/{id}/ [put]
+
+// UpdateCommodity godoc
+// @Summary      Update commodity fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         commodity
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Commodity id"
+// @Param        body  body  CreateCommodityRequest  true  "Fields to change"
+// @Success      200  {object}  CommodityResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /commodity/api/v1/{id}/ [patch]
+
+// DeleteCommodity godoc
+// @Summary      Delete commodity
+// @Tags         commodity
+// @Param        id  path  int  true  "Commodity id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /commodity/api/v1/{id}/ [delete]
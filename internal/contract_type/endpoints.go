@@ -0,0 +1,19 @@
+package contract_type
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[ContractType, CreateContractTypeRequest, ContractTypeResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "contract type",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToContractTypeResponse,
+	})
+}
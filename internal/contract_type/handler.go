@@ -0,0 +1,9 @@
+package contract_type
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "contract_type"
+
+var ErrContractTypeNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[ContractType, CreateContractTypeRequest, ContractTypeResponse]
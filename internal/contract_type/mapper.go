@@ -0,0 +1,9 @@
+package contract_type
+
+func ToContractTypeResponse(contractType ContractType) ContractTypeResponse {
+	return ContractTypeResponse{
+		Id:          &contractType.Id,
+		Description: &contractType.Description,
+		Persianname: &contractType.Persianname,
+	}
+}
@@ -0,0 +1,5 @@
+package contract_type
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[ContractType]
@@ -0,0 +1,13 @@
+package contract_type
+
+type CreateContractTypeRequest struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+}
+
+type ContractTypeResponse struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+}
@@ -0,0 +1,101 @@
+package contract_type
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// contract type routes live here; make docs reads them with --parseFuncBody.
+
+// ListContractType godoc
+// @Summary      List contract type
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         contract type
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]ContractTypeResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /contract-type/api/v1/ [get]
+
+// SearchContractType godoc
+// @Summary      Search contract type
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         contract type
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]ContractTypeResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /contract-type/api/v1/search/ [post]
+
+// GetContractType godoc
+// @Summary      Get contract type by id
+// @Tags         contract type
+// @Produce      json
+// @Param        id      path   int     true   "ContractType id"
+// @Success      200  {object}  ContractTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /contract-type/api/v1/{id}/ [get]
+
+// CreateContractType godoc
+// @Summary      Create contract type
+// @Tags         contract type
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateContractTypeRequest  true  "ContractType"
+// @Success      201  {object}  ContractTypeResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /contract-type/api/v1/ [post]
+
+// ReplaceContractType godoc
+// @Summary      Replace contract type
+// @Description  Fields left out of the body are cleared.
+// @Tags         contract type
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "ContractType id"
+// @Param        body  body  CreateContractTypeRequest  true  "ContractType"
+// @Success      200  {object}  ContractTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /contract-type/api/v1/{id}/ [put]
+
+// UpdateContractType godoc
+// @Summary      Update contract type fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         contract type
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "ContractType id"
+// @Param        body  body  CreateContractTypeRequest  true  "Fields to change"
+// @Success      200  {object}  ContractTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /contract-type/api/v1/{id}/ [patch]
+
+// DeleteContractType godoc
+// @Summary      Delete contract type
+// @Tags         contract type
+// @Param        id  path  int  true  "ContractType id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /contract-type/api/v1/{id}/ [delete]
@@ -0,0 +1,19 @@
+package currency_unit
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[CurrencyUnit, CreateCurrencyUnitRequest, CurrencyUnitResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "currency unit",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToCurrencyUnitResponse,
+	})
+}
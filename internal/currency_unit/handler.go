@@ -0,0 +1,9 @@
+package currency_unit
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "currency_unit"
+
+var ErrCurrencyUnitNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[CurrencyUnit, CreateCurrencyUnitRequest, CurrencyUnitResponse]
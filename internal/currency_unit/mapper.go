@@ -0,0 +1,9 @@
+package currency_unit
+
+func ToCurrencyUnitResponse(currencyUnit CurrencyUnit) CurrencyUnitResponse {
+	return CurrencyUnitResponse{
+		Id:          &currencyUnit.Id,
+		Description: &currencyUnit.Description,
+		Persianname: &currencyUnit.Persianname,
+	}
+}
@@ -0,0 +1,5 @@
+package currency_unit
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[CurrencyUnit]
@@ -0,0 +1,13 @@
+package currency_unit
+
+type CreateCurrencyUnitRequest struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+}
+
+type CurrencyUnitResponse struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+}
@@ -0,0 +1,101 @@
+package currency_unit
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// currency unit routes live here; make docs reads them with --parseFuncBody.
+
+// ListCurrencyUnit godoc
+// @Summary      List currency unit
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         currency unit
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]CurrencyUnitResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /currency-unit/api/v1/ [get]
+
+// SearchCurrencyUnit godoc
+// @Summary      Search currency unit
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         currency unit
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]CurrencyUnitResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /currency-unit/api/v1/search/ [post]
+
+// GetCurrencyUnit godoc
+// @Summary      Get currency unit by id
+// @Tags         currency unit
+// @Produce      json
+// @Param        id      path   int     true   "CurrencyUnit id"
+// @Success      200  {object}  CurrencyUnitResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /currency-unit/api/v1/{id}/ [get]
+
+// CreateCurrencyUnit godoc
+// @Summary      Create currency unit
+// @Tags         currency unit
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateCurrencyUnitRequest  true  "CurrencyUnit"
+// @Success      201  {object}  CurrencyUnitResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /currency-unit/api/v1/ [post]
+
+// ReplaceCurrencyUnit godoc
+// @Summary      Replace currency unit
+// @Description  Fields left out of the body are cleared.
+// @Tags         currency unit
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "CurrencyUnit id"
+// @Param        body  body  CreateCurrencyUnitRequest  true  "CurrencyUnit"
+// @Success      200  {object}  CurrencyUnitResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /currency-unit/api/v1/{id}/ [put]
+
+// UpdateCurrencyUnit godoc
+// @Summary      Update currency unit fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         currency unit
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "CurrencyUnit id"
+// @Param        body  body  CreateCurrencyUnitRequest  true  "Fields to change"
+// @Success      200  {object}  CurrencyUnitResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /currency-unit/api/v1/{id}/ [patch]
+
+// DeleteCurrencyUnit godoc
+// @Summary      Delete currency unit
+// @Tags         currency unit
+// @Param        id  path  int  true  "CurrencyUnit id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /currency-unit/api/v1/{id}/ [delete]
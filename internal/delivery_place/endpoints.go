@@ -0,0 +1,19 @@
+package delivery_place
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[DeliveryPlace, CreateDeliveryPlaceRequest, DeliveryPlaceResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "delivery place",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToDeliveryPlaceResponse,
+	})
+}
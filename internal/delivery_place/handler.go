@@ -0,0 +1,9 @@
+package delivery_place
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "delivery_place"
+
+var ErrDeliveryPlaceNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[DeliveryPlace, CreateDeliveryPlaceRequest, DeliveryPlaceResponse]
@@ -0,0 +1,9 @@
+package delivery_place
+
+func ToDeliveryPlaceResponse(deliveryPlace DeliveryPlace) DeliveryPlaceResponse {
+	return DeliveryPlaceResponse{
+		Id:          &deliveryPlace.Id,
+		Description: &deliveryPlace.Description,
+		Persianname: &deliveryPlace.Persianname,
+	}
+}
@@ -0,0 +1,7 @@
+package delivery_place
+
+type DeliveryPlace struct {
+	Id          int    `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description string `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname string `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+}
@@ -0,0 +1,5 @@
+package delivery_place
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[DeliveryPlace]
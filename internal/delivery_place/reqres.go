@@ -0,0 +1,13 @@
+package delivery_place
+
+type CreateDeliveryPlaceRequest struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+}
+
+type DeliveryPlaceResponse struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+}
@@ -0,0 +1,101 @@
+package delivery_place
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// delivery place routes live here; make docs reads them with --parseFuncBody.
+
+// ListDeliveryPlace godoc
+// @Summary      List delivery place
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         delivery place
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]DeliveryPlaceResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /delivery-place/api/v1/ [get]
+
+// SearchDeliveryPlace godoc
+// @Summary      Search delivery place
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         delivery place
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]DeliveryPlaceResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /delivery-place/api/v1/search/ [post]
+
+// GetDeliveryPlace godoc
+// @Summary      Get delivery place by id
+// @Tags         delivery place
+// @Produce      json
+// @Param        id      path   int     true   "DeliveryPlace id"
+// @Success      200  {object}  DeliveryPlaceResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /delivery-place/api/v1/{id}/ [get]
+
+// CreateDeliveryPlace godoc
+// @Summary      Create delivery place
+// @Tags         delivery place
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateDeliveryPlaceRequest  true  "DeliveryPlace"
+// @Success      201  {object}  DeliveryPlaceResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /delivery-place/api/v1/ [post]
+
+// ReplaceDeliveryPlace godoc
+// @Summary      Replace delivery place
+// @Description  Fields left out of the body are cleared.
+// @Tags         delivery place
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "DeliveryPlace id"
+// @Param        body  body  CreateDeliveryPlaceRequest  true  "DeliveryPlace"
+// @Success      200  {object}  DeliveryPlaceResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /delivery-place/api/v1/{id}/ [put]
+
+// UpdateDeliveryPlace godoc
+// @Summary      Update delivery place fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         delivery place
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "DeliveryPlace id"
+// @Param        body  body  CreateDeliveryPlaceRequest  true  "Fields to change"
+// @Success      200  {object}  DeliveryPlaceResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /delivery-place/api/v1/{id}/ [patch]
+
+// DeleteDeliveryPlace godoc
+// @Summary      Delete delivery place
+// @Tags         delivery place
+// @Param        id  path  int  true  "DeliveryPlace id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /delivery-place/api/v1/{id}/ [delete]
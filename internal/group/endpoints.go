@@ -0,0 +1,20 @@
+package group
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[Group, CreateGroupRequest, GroupResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "group",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToGroupResponse,
+		Expand:      crud.ExpandMap(references),
+	})
+}
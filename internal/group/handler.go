@@ -0,0 +1,9 @@
+package group
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "group"
+
+var ErrGroupNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[Group, CreateGroupRequest, GroupResponse]
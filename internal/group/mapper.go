@@ -0,0 +1,19 @@
+package group
+
+import (
+	"ibrokers_service/internal/main_group"
+)
+
+func ToGroupResponse(group Group) GroupResponse {
+	response := GroupResponse{
+		Id:          &group.Id,
+		Description: &group.Description,
+		Persianname: &group.Persianname,
+		Maingroupid: group.Maingroupid,
+	}
+	if group.MainGroup != nil {
+		expanded := main_group.ToMainGroupResponse(*group.MainGroup)
+		response.MainGroup = &expanded
+	}
+	return response
+}
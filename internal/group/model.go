@@ -0,0 +1,13 @@
+package group
+
+import (
+	"ibrokers_service/internal/main_group"
+)
+
+type Group struct {
+	Id          int                   `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description string                `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname string                `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+	Maingroupid *int                  `form:"mainGroupId" json:"mainGroupId" filter:"mainGroupId" ordering:"mainGroupId" gorm:"index"`
+	MainGroup   *main_group.MainGroup `form:"-" json:"-" gorm:"foreignKey:Maingroupid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
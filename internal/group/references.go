@@ -0,0 +1,16 @@
+package group
+
+import (
+	"ibrokers_service/internal/main_group"
+	"ibrokers_service/pkg/crud"
+)
+
+var references = []crud.Reference[Group]{
+	{
+		Field:       "mainGroupId",
+		Expand:      "main_group",
+		Association: "MainGroup",
+		Model:       &main_group.MainGroup{},
+		Id:          func(g Group) *int { return g.Maingroupid },
+	},
+}
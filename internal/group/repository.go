@@ -0,0 +1,5 @@
+package group
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[Group]
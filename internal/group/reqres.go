@@ -0,0 +1,20 @@
+package group
+
+import (
+	"ibrokers_service/internal/main_group"
+)
+
+type CreateGroupRequest struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+	Maingroupid *int    `form:"mainGroupId" json:"mainGroupId"`
+}
+
+type GroupResponse struct {
+	Id          *int                          `form:"id" json:"id"`
+	Description *string                       `form:"description" json:"description"`
+	Persianname *string                       `form:"persianName" json:"persianName"`
+	Maingroupid *int                          `form:"mainGroupId" json:"mainGroupId"`
+	MainGroup   *main_group.MainGroupResponse `json:"mainGroup,omitempty"`
+}
@@ -0,0 +1,102 @@
+package group
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// group routes live here; make docs reads them with --parseFuncBody.
+
+// ListGroup godoc
+// @Summary      List group
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         group
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]GroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group/api/v1/ [get]
+
+// SearchGroup godoc
+// @Summary      Search group
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         group
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]GroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group/api/v1/search/ [post]
+
+// GetGroup godoc
+// @Summary      Get group by id
+// @Tags         group
+// @Produce      json
+// @Param        id      path   int     true   "Group id"
+// @Param        expand  query  string  false  "Comma separated associations to embed: main_group"
+// @Success      200  {object}  GroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group/api/v1/{id}/ [get]
+
+// CreateGroup godoc
+// @Summary      Create group
+// @Tags         group
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateGroupRequest  true  "Group"
+// @Success      201  {object}  GroupResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group/api/v1/ [post]
+
+// ReplaceGroup godoc
+// @Summary      Replace group
+// @Description  Fields left out of the body are cleared.
+// @Tags         group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Group id"
+// @Param        body  body  CreateGroupRequest  true  "Group"
+// @Success      200  {object}  GroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group/api/v1/{id}/ [put]
+
+// UpdateGroup godoc
+// @Summary      Update group fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Group id"
+// @Param        body  body  CreateGroupRequest  true  "Fields to change"
+// @Success      200  {object}  GroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group/api/v1/{id}/ [patch]
+
+// DeleteGroup godoc
+// @Summary      Delete group
+// @Tags         group
+// @Param        id  path  int  true  "Group id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group/api/v1/{id}/ [delete]
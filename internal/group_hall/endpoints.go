@@ -0,0 +1,20 @@
+package group_hall
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[GroupHall, CreateGroupHallRequest, GroupHallResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "group hall",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToGroupHallResponse,
+		Expand:      crud.ExpandMap(references),
+	})
+}
@@ -0,0 +1,9 @@
+package group_hall
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "group_hall"
+
+var ErrGroupHallNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[GroupHall, CreateGroupHallRequest, GroupHallResponse]
@@ -0,0 +1,25 @@
+package group_hall
+
+import (
+	"ibrokers_service/internal/group"
+	"ibrokers_service/internal/trading_hall"
+)
+
+func ToGroupHallResponse(groupHall GroupHall) GroupHallResponse {
+	response := GroupHallResponse{
+		Id:            &groupHall.Id,
+		Description:   &groupHall.Description,
+		Persianname:   &groupHall.Persianname,
+		Groupid:       groupHall.Groupid,
+		Tradinghallid: groupHall.Tradinghallid,
+	}
+	if groupHall.Group != nil {
+		expanded := group.ToGroupResponse(*groupHall.Group)
+		response.Group = &expanded
+	}
+	if groupHall.TradingHall != nil {
+		expanded := trading_hall.ToTradingHallResponse(*groupHall.TradingHall)
+		response.TradingHall = &expanded
+	}
+	return response
+}
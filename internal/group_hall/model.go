@@ -0,0 +1,16 @@
+package group_hall
+
+import (
+	"ibrokers_service/internal/group"
+	"ibrokers_service/internal/trading_hall"
+)
+
+type GroupHall struct {
+	Id            int                       `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description   string                    `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname   string                    `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+	Groupid       *int                      `form:"groupId" json:"groupId" filter:"groupId" ordering:"groupId" gorm:"index"`
+	Group         *group.Group              `form:"-" json:"-" gorm:"foreignKey:Groupid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Tradinghallid *int                      `form:"tradingHallId" json:"tradingHallId" filter:"tradingHallId" ordering:"tradingHallId" gorm:"index"`
+	TradingHall   *trading_hall.TradingHall `form:"-" json:"-" gorm:"foreignKey:Tradinghallid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
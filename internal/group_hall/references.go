@@ -0,0 +1,24 @@
+package group_hall
+
+import (
+	"ibrokers_service/internal/group"
+	"ibrokers_service/internal/trading_hall"
+	"ibrokers_service/pkg/crud"
+)
+
+var references = []crud.Reference[GroupHall]{
+	{
+		Field:       "groupId",
+		Expand:      "group",
+		Association: "Group",
+		Model:       &group.Group{},
+		Id:          func(g GroupHall) *int { return g.Groupid },
+	},
+	{
+		Field:       "tradingHallId",
+		Expand:      "trading_hall",
+		Association: "TradingHall",
+		Model:       &trading_hall.TradingHall{},
+		Id:          func(g GroupHall) *int { return g.Tradinghallid },
+	},
+}
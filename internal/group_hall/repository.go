@@ -0,0 +1,5 @@
+package group_hall
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[GroupHall]
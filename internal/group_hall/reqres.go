@@ -0,0 +1,24 @@
+package group_hall
+
+import (
+	"ibrokers_service/internal/group"
+	"ibrokers_service/internal/trading_hall"
+)
+
+type CreateGroupHallRequest struct {
+	Id            *int    `form:"id" json:"id"`
+	Description   *string `form:"description" json:"description"`
+	Persianname   *string `form:"persianName" json:"persianName"`
+	Groupid       *int    `form:"groupId" json:"groupId"`
+	Tradinghallid *int    `form:"tradingHallId" json:"tradingHallId"`
+}
+
+type GroupHallResponse struct {
+	Id            *int                              `form:"id" json:"id"`
+	Description   *string                           `form:"description" json:"description"`
+	Persianname   *string                           `form:"persianName" json:"persianName"`
+	Groupid       *int                              `form:"groupId" json:"groupId"`
+	Group         *group.GroupResponse              `json:"group,omitempty"`
+	Tradinghallid *int                              `form:"tradingHallId" json:"tradingHallId"`
+	TradingHall   *trading_hall.TradingHallResponse `json:"tradingHall,omitempty"`
+}
@@ -0,0 +1,12 @@
+package group_hall
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[GroupHall]
+
+func NewService(rep Repository) Service {
+	return Service{
+		Repository: rep,
+		Validate:   crud.CheckReferences(rep, references),
+	}
+}
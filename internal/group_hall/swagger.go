@@ -0,0 +1,102 @@
+package group_hall
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// group hall routes live here; make docs reads them with --parseFuncBody.
+
+// ListGroupHall godoc
+// @Summary      List group hall
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         group hall
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]GroupHallResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group-hall/api/v1/ [get]
+
+// SearchGroupHall godoc
+// @Summary      Search group hall
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         group hall
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]GroupHallResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group-hall/api/v1/search/ [post]
+
+// GetGroupHall godoc
+// @Summary      Get group hall by id
+// @Tags         group hall
+// @Produce      json
+// @Param        id      path   int     true   "GroupHall id"
+// @Param        expand  query  string  false  "Comma separated associations to embed: group, trading_hall"
+// @Success      200  {object}  GroupHallResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group-hall/api/v1/{id}/ [get]
+
+// CreateGroupHall godoc
+// @Summary      Create group hall
+// @Tags         group hall
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateGroupHallRequest  true  "GroupHall"
+// @Success      201  {object}  GroupHallResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group-hall/api/v1/ [post]
+
+// ReplaceGroupHall godoc
+// @Summary      Replace group hall
+// @Description  Fields left out of the body are cleared.
+// @Tags         group hall
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "GroupHall id"
+// @Param        body  body  CreateGroupHallRequest  true  "GroupHall"
+// @Success      200  {object}  GroupHallResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group-hall/api/v1/{id}/ [put]
+
+// UpdateGroupHall godoc
+// @Summary      Update group hall fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         group hall
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "GroupHall id"
+// @Param        body  body  CreateGroupHallRequest  true  "Fields to change"
+// @Success      200  {object}  GroupHallResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group-hall/api/v1/{id}/ [patch]
+
+// DeleteGroupHall godoc
+// @Summary      Delete group hall
+// @Tags         group hall
+// @Param        id  path  int  true  "GroupHall id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /group-hall/api/v1/{id}/ [delete]
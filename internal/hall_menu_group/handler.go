@@ -0,0 +1,9 @@
+package hall_menu_group
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "hall_menu_group"
+
+var ErrHallMenuGroupNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[HallMenuGroup, CreateHallMenuGroupRequest, HallMenuGroupResponse]
@@ -0,0 +1,19 @@
+package hall_menu_group
+
+import (
+	"ibrokers_service/internal/trading_hall"
+)
+
+func ToHallMenuGroupResponse(hallMenuGroup HallMenuGroup) HallMenuGroupResponse {
+	response := HallMenuGroupResponse{
+		Id:            &hallMenuGroup.Id,
+		Description:   &hallMenuGroup.Description,
+		Persianname:   &hallMenuGroup.Persianname,
+		Tradinghallid: hallMenuGroup.Tradinghallid,
+	}
+	if hallMenuGroup.TradingHall != nil {
+		expanded := trading_hall.ToTradingHallResponse(*hallMenuGroup.TradingHall)
+		response.TradingHall = &expanded
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package hall_menu_group
+
+import (
+	"ibrokers_service/internal/trading_hall"
+)
+
+type HallMenuGroup struct {
+	Id            int                       `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description   string                    `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname   string                    `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+	Tradinghallid *int                      `form:"tradingHallId" json:"tradingHallId" filter:"tradingHallId" ordering:"tradingHallId" gorm:"index"`
+	TradingHall   *trading_hall.TradingHall `form:"-" json:"-" gorm:"foreignKey:Tradinghallid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
@@ -0,0 +1,16 @@
+package hall_menu_group
+
+import (
+	"ibrokers_service/internal/trading_hall"
+	"ibrokers_service/pkg/crud"
+)
+
+var references = []crud.Reference[HallMenuGroup]{
+	{
+		Field:       "tradingHallId",
+		Expand:      "trading_hall",
+		Association: "TradingHall",
+		Model:       &trading_hall.TradingHall{},
+		Id:          func(h HallMenuGroup) *int { return h.Tradinghallid },
+	},
+}
@@ -0,0 +1,20 @@
+package hall_menu_group
+
+import (
+	"ibrokers_service/internal/trading_hall"
+)
+
+type CreateHallMenuGroupRequest struct {
+	Id            *int    `form:"id" json:"id"`
+	Description   *string `form:"description" json:"description"`
+	Persianname   *string `form:"persianName" json:"persianName"`
+	Tradinghallid *int    `form:"tradingHallId" json:"tradingHallId"`
+}
+
+type HallMenuGroupResponse struct {
+	Id            *int                              `form:"id" json:"id"`
+	Description   *string                           `form:"description" json:"description"`
+	Persianname   *string                           `form:"persianName" json:"persianName"`
+	Tradinghallid *int                              `form:"tradingHallId" json:"tradingHallId"`
+	TradingHall   *trading_hall.TradingHallResponse `json:"tradingHall,omitempty"`
+}
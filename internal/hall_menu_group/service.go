@@ -0,0 +1,12 @@
+package hall_menu_group
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[HallMenuGroup]
+
+func NewService(rep Repository) Service {
+	return Service{
+		Repository: rep,
+		Validate:   crud.CheckReferences(rep, references),
+	}
+}
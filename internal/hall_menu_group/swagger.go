@@ -0,0 +1,102 @@
+package hall_menu_group
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// hall menu group routes live here; make docs reads them with --parseFuncBody.
+
+// ListHallMenuGroup godoc
+// @Summary      List hall menu group
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         hall menu group
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]HallMenuGroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-group/api/v1/ [get]
+
+// SearchHallMenuGroup godoc
+// @Summary      Search hall menu group
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         hall menu group
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]HallMenuGroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-group/api/v1/search/ [post]
+
+// GetHallMenuGroup godoc
+// @Summary      Get hall menu group by id
+// @Tags         hall menu group
+// @Produce      json
+// @Param        id      path   int     true   "HallMenuGroup id"
+// @Param        expand  query  string  false  "Comma separated associations to embed: trading_hall"
+// @Success      200  {object}  HallMenuGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-group/api/v1/{id}/ [get]
+
+// CreateHallMenuGroup godoc
+// @Summary      Create hall menu group
+// @Tags         hall menu group
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateHallMenuGroupRequest  true  "HallMenuGroup"
+// @Success      201  {object}  HallMenuGroupResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-group/api/v1/ [post]
+
+// ReplaceHallMenuGroup godoc
+// @Summary      Replace hall menu group
+// @Description  Fields left out of the body are cleared.
+// @Tags         hall menu group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "HallMenuGroup id"
+// @Param        body  body  CreateHallMenuGroupRequest  true  "HallMenuGroup"
+// @Success      200  {object}  HallMenuGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-group/api/v1/{id}/ [put]
+
+// UpdateHallMenuGroup godoc
+// @Summary      Update hall menu group fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         hall menu group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "HallMenuGroup id"
+// @Param        body  body  CreateHallMenuGroupRequest  true  "Fields to change"
+// @Success      200  {object}  HallMenuGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-group/api/v1/{id}/ [patch]
+
+// DeleteHallMenuGroup godoc
+// @Summary      Delete hall menu group
+// @Tags         hall menu group
+// @Param        id  path  int  true  "HallMenuGroup id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-group/api/v1/{id}/ [delete]
@@ -0,0 +1,20 @@
+package hall_menu_sub_group
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[HallMenuSubGroup, CreateHallMenuSubGroupRequest, HallMenuSubGroupResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "hall menu sub group",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToHallMenuSubGroupResponse,
+		Expand:      crud.ExpandMap(references),
+	})
+}
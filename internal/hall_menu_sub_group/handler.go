@@ -0,0 +1,9 @@
+package hall_menu_sub_group
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "hall_menu_sub_group"
+
+var ErrHallMenuSubGroupNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[HallMenuSubGroup, CreateHallMenuSubGroupRequest, HallMenuSubGroupResponse]
@@ -0,0 +1,19 @@
+package hall_menu_sub_group
+
+import (
+	"ibrokers_service/internal/hall_menu_group"
+)
+
+func ToHallMenuSubGroupResponse(hallMenuSubGroup HallMenuSubGroup) HallMenuSubGroupResponse {
+	response := HallMenuSubGroupResponse{
+		Id:              &hallMenuSubGroup.Id,
+		Description:     &hallMenuSubGroup.Description,
+		Persianname:     &hallMenuSubGroup.Persianname,
+		Hallmenugroupid: hallMenuSubGroup.Hallmenugroupid,
+	}
+	if hallMenuSubGroup.HallMenuGroup != nil {
+		expanded := hall_menu_group.ToHallMenuGroupResponse(*hallMenuSubGroup.HallMenuGroup)
+		response.HallMenuGroup = &expanded
+	}
+	return response
+}
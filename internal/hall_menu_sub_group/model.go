@@ -0,0 +1,13 @@
+package hall_menu_sub_group
+
+import (
+	"ibrokers_service/internal/hall_menu_group"
+)
+
+type HallMenuSubGroup struct {
+	Id              int                            `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description     string                         `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname     string                         `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+	Hallmenugroupid *int                           `form:"hallMenuGroupId" json:"hallMenuGroupId" filter:"hallMenuGroupId" ordering:"hallMenuGroupId" gorm:"index"`
+	HallMenuGroup   *hall_menu_group.HallMenuGroup `form:"-" json:"-" gorm:"foreignKey:Hallmenugroupid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
@@ -0,0 +1,16 @@
+package hall_menu_sub_group
+
+import (
+	"ibrokers_service/internal/hall_menu_group"
+	"ibrokers_service/pkg/crud"
+)
+
+var references = []crud.Reference[HallMenuSubGroup]{
+	{
+		Field:       "hallMenuGroupId",
+		Expand:      "hall_menu_group",
+		Association: "HallMenuGroup",
+		Model:       &hall_menu_group.HallMenuGroup{},
+		Id:          func(h HallMenuSubGroup) *int { return h.Hallmenugroupid },
+	},
+}
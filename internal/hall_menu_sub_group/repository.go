@@ -0,0 +1,5 @@
+package hall_menu_sub_group
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[HallMenuSubGroup]
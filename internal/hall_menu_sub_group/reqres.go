@@ -0,0 +1,20 @@
+package hall_menu_sub_group
+
+import (
+	"ibrokers_service/internal/hall_menu_group"
+)
+
+type CreateHallMenuSubGroupRequest struct {
+	Id              *int    `form:"id" json:"id"`
+	Description     *string `form:"description" json:"description"`
+	Persianname     *string `form:"persianName" json:"persianName"`
+	Hallmenugroupid *int    `form:"hallMenuGroupId" json:"hallMenuGroupId"`
+}
+
+type HallMenuSubGroupResponse struct {
+	Id              *int                                   `form:"id" json:"id"`
+	Description     *string                                `form:"description" json:"description"`
+	Persianname     *string                                `form:"persianName" json:"persianName"`
+	Hallmenugroupid *int                                   `form:"hallMenuGroupId" json:"hallMenuGroupId"`
+	HallMenuGroup   *hall_menu_group.HallMenuGroupResponse `json:"hallMenuGroup,omitempty"`
+}
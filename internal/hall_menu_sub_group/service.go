@@ -0,0 +1,12 @@
+package hall_menu_sub_group
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[HallMenuSubGroup]
+
+func NewService(rep Repository) Service {
+	return Service{
+		Repository: rep,
+		Validate:   crud.CheckReferences(rep, references),
+	}
+}
@@ -0,0 +1,102 @@
+package hall_menu_sub_group
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// hall menu sub group routes live here; make docs reads them with --parseFuncBody.
+
+// ListHallMenuSubGroup godoc
+// @Summary      List hall menu sub group
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         hall menu sub group
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]HallMenuSubGroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-sub-group/api/v1/ [get]
+
+// SearchHallMenuSubGroup godoc
+// @Summary      Search hall menu sub group
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         hall menu sub group
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]HallMenuSubGroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-sub-group/api/v1/search/ [post]
+
+// GetHallMenuSubGroup godoc
+// @Summary      Get hall menu sub group by id
+// @Tags         hall menu sub group
+// @Produce      json
+// @Param        id      path   int     true   "HallMenuSubGroup id"
+// @Param        expand  query  string  false  "Comma separated associations to embed: hall_menu_group"
+// @Success      200  {object}  HallMenuSubGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-sub-group/api/v1/{id}/ [get]
+
+// CreateHallMenuSubGroup godoc
+// @Summary      Create hall menu sub group
+// @Tags         hall menu sub group
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateHallMenuSubGroupRequest  true  "HallMenuSubGroup"
+// @Success      201  {object}  HallMenuSubGroupResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-sub-group/api/v1/ [post]
+
+// ReplaceHallMenuSubGroup godoc
+// @Summary      Replace hall menu sub group
+// @Description  Fields left out of the body are cleared.
+// @Tags         hall menu sub group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "HallMenuSubGroup id"
+// @Param        body  body  CreateHallMenuSubGroupRequest  true  "HallMenuSubGroup"
+// @Success      200  {object}  HallMenuSubGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-sub-group/api/v1/{id}/ [put]
+
+// UpdateHallMenuSubGroup godoc
+// @Summary      Update hall menu sub group fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         hall menu sub group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "HallMenuSubGroup id"
+// @Param        body  body  CreateHallMenuSubGroupRequest  true  "Fields to change"
+// @Success      200  {object}  HallMenuSubGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-sub-group/api/v1/{id}/ [patch]
+
+// DeleteHallMenuSubGroup godoc
+// @Summary      Delete hall menu sub group
+// @Tags         hall menu sub group
+// @Param        id  path  int  true  "HallMenuSubGroup id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /hall-menu-sub-group/api/v1/{id}/ [delete]
@@ -0,0 +1,19 @@
+package main_group
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[MainGroup, CreateMainGroupRequest, MainGroupResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "main group",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToMainGroupResponse,
+	})
+}
@@ -0,0 +1,9 @@
+package main_group
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "main_group"
+
+var ErrMainGroupNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[MainGroup, CreateMainGroupRequest, MainGroupResponse]
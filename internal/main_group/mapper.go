@@ -0,0 +1,9 @@
+package main_group
+
+func ToMainGroupResponse(mainGroup MainGroup) MainGroupResponse {
+	return MainGroupResponse{
+		Id:          &mainGroup.Id,
+		Description: &mainGroup.Description,
+		Persianname: &mainGroup.Persianname,
+	}
+}
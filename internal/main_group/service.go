@@ -0,0 +1,5 @@
+package main_group
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[MainGroup]
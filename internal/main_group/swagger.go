@@ -0,0 +1,101 @@
+package main_group
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// main group routes live here; make docs reads them with --parseFuncBody.
+
+// ListMainGroup godoc
+// @Summary      List main group
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         main group
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]MainGroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /main-group/api/v1/ [get]
+
+// SearchMainGroup godoc
+// @Summary      Search main group
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         main group
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]MainGroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /main-group/api/v1/search/ [post]
+
+// GetMainGroup godoc
+// @Summary      Get main group by id
+// @Tags         main group
+// @Produce      json
+// @Param        id      path   int     true   "MainGroup id"
+// @Success      200  {object}  MainGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /main-group/api/v1/{id}/ [get]
+
+// CreateMainGroup godoc
+// @Summary      Create main group
+// @Tags         main group
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateMainGroupRequest  true  "MainGroup"
+// @Success      201  {object}  MainGroupResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /main-group/api/v1/ [post]
+
+// ReplaceMainGroup godoc
+// @Summary      Replace main group
+// @Description  Fields left out of the body are cleared.
+// @Tags         main group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "MainGroup id"
+// @Param        body  body  CreateMainGroupRequest  true  "MainGroup"
+// @Success      200  {object}  MainGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /main-group/api/v1/{id}/ [put]
+
+// UpdateMainGroup godoc
+// @Summary      Update main group fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         main group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "MainGroup id"
+// @Param        body  body  CreateMainGroupRequest  true  "Fields to change"
+// @Success      200  {object}  MainGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /main-group/api/v1/{id}/ [patch]
+
+// DeleteMainGroup godoc
+// @Summary      Delete main group
+// @Tags         main group
+// @Param        id  path  int  true  "MainGroup id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /main-group/api/v1/{id}/ [delete]
@@ -0,0 +1,19 @@
+package manufacturers
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[Manufacturers, CreateManufacturersRequest, ManufacturersResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "manufacturers",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToManufacturersResponse,
+	})
+}
@@ -0,0 +1,9 @@
+package manufacturers
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "manufacturers"
+
+var ErrManufacturersNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[Manufacturers, CreateManufacturersRequest, ManufacturersResponse]
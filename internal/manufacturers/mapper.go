@@ -0,0 +1,9 @@
+package manufacturers
+
+func ToManufacturersResponse(manufacturers Manufacturers) ManufacturersResponse {
+	return ManufacturersResponse{
+		Id:          &manufacturers.Id,
+		Description: &manufacturers.Description,
+		Persianname: &manufacturers.Persianname,
+	}
+}
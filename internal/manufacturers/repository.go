@@ -0,0 +1,5 @@
+package manufacturers
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[Manufacturers]
@@ -0,0 +1,101 @@
+package manufacturers
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// manufacturers routes live here; make docs reads them with --parseFuncBody.
+
+// ListManufacturers godoc
+// @Summary      List manufacturers
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         manufacturers
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]ManufacturersResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /manufacturers/api/v1/ [get]
+
+// SearchManufacturers godoc
+// @Summary      Search manufacturers
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         manufacturers
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]ManufacturersResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /manufacturers/api/v1/search/ [post]
+
+// GetManufacturers godoc
+// @Summary      Get manufacturers by id
+// @Tags         manufacturers
+// @Produce      json
+// @Param        id      path   int     true   "Manufacturers id"
+// @Success      200  {object}  ManufacturersResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /manufacturers/api/v1/{id}/ [get]
+
+// CreateManufacturers godoc
+// @Summary      Create manufacturers
+// @Tags         manufacturers
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateManufacturersRequest  true  "Manufacturers"
+// @Success      201  {object}  ManufacturersResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /manufacturers/api/v1/ [post]
+
+// ReplaceManufacturers godoc
+// @Summary      Replace manufacturers
+// @Description  Fields left out of the body are cleared.
+// @Tags         manufacturers
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Manufacturers id"
+// @Param        body  body  CreateManufacturersRequest  true  "Manufacturers"
+// @Success      200  {object}  ManufacturersResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /manufacturers/api/v1/{id}/ [put]
+
+// UpdateManufacturers godoc
+// @Summary      Update manufacturers fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         manufacturers
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Manufacturers id"
+// @Param        body  body  CreateManufacturersRequest  true  "Fields to change"
+// @Success      200  {object}  ManufacturersResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /manufacturers/api/v1/{id}/ [patch]
+
+// DeleteManufacturers godoc
+// @Summary      Delete manufacturers
+// @Tags         manufacturers
+// @Param        id  path  int  true  "Manufacturers id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /manufacturers/api/v1/{id}/ [delete]
@@ -0,0 +1,19 @@
+package measure_unit
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[MeasureUnit, CreateMeasureUnitRequest, MeasureUnitResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "measure unit",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToMeasureUnitResponse,
+	})
+}
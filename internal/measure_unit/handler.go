@@ -0,0 +1,9 @@
+package measure_unit
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "measure_unit"
+
+var ErrMeasureUnitNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[MeasureUnit, CreateMeasureUnitRequest, MeasureUnitResponse]
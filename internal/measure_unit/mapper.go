@@ -0,0 +1,9 @@
+package measure_unit
+
+func ToMeasureUnitResponse(measureUnit MeasureUnit) MeasureUnitResponse {
+	return MeasureUnitResponse{
+		Id:          &measureUnit.Id,
+		Description: &measureUnit.Description,
+		Persianname: &measureUnit.Persianname,
+	}
+}
@@ -0,0 +1,5 @@
+package measure_unit
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[MeasureUnit]
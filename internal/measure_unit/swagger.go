@@ -0,0 +1,101 @@
+package measure_unit
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// measure unit routes live here; make docs reads them with --parseFuncBody.
+
+// ListMeasureUnit godoc
+// @Summary      List measure unit
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         measure unit
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]MeasureUnitResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /measure-unit/api/v1/ [get]
+
+// SearchMeasureUnit godoc
+// @Summary      Search measure unit
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         measure unit
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]MeasureUnitResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /measure-unit/api/v1/search/ [post]
+
+// GetMeasureUnit godoc
+// @Summary      Get measure unit by id
+// @Tags         measure unit
+// @Produce      json
+// @Param        id      path   int     true   "MeasureUnit id"
+// @Success      200  {object}  MeasureUnitResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /measure-unit/api/v1/{id}/ [get]
+
+// CreateMeasureUnit godoc
+// @Summary      Create measure unit
+// @Tags         measure unit
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateMeasureUnitRequest  true  "MeasureUnit"
+// @Success      201  {object}  MeasureUnitResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /measure-unit/api/v1/ [post]
+
+// ReplaceMeasureUnit godoc
+// @Summary      Replace measure unit
+// @Description  Fields left out of the body are cleared.
+// @Tags         measure unit
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "MeasureUnit id"
+// @Param        body  body  CreateMeasureUnitRequest  true  "MeasureUnit"
+// @Success      200  {object}  MeasureUnitResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /measure-unit/api/v1/{id}/ [put]
+
+// UpdateMeasureUnit godoc
+// @Summary      Update measure unit fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         measure unit
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "MeasureUnit id"
+// @Param        body  body  CreateMeasureUnitRequest  true  "Fields to change"
+// @Success      200  {object}  MeasureUnitResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /measure-unit/api/v1/{id}/ [patch]
+
+// DeleteMeasureUnit godoc
+// @Summary      Delete measure unit
+// @Tags         measure unit
+// @Param        id  path  int  true  "MeasureUnit id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /measure-unit/api/v1/{id}/ [delete]
@@ -1,48 +1,133 @@
 package offer
 
+import (
+	"ibrokers_service/internal/broker"
+	"ibrokers_service/internal/buy_method"
+	"ibrokers_service/internal/commodity"
+	"ibrokers_service/internal/contract_type"
+	"ibrokers_service/internal/currency_unit"
+	"ibrokers_service/internal/delivery_place"
+	"ibrokers_service/internal/manufacturers"
+	"ibrokers_service/internal/measure_unit"
+	"ibrokers_service/internal/offer_mod"
+	"ibrokers_service/internal/offer_type"
+	"ibrokers_service/internal/packaging_type"
+	"ibrokers_service/internal/settlement"
+	"ibrokers_service/internal/supplier"
+	"ibrokers_service/internal/trading_hall"
+	"ibrokers_service/pkg/jdate"
+)
 
+func ToOfferResponse(offer Offer) OfferResponse {
+	response := OfferResponse{
+		Buymethodid:               offer.Buymethodid,
+		Brokerid:                  offer.Brokerid,
+		Commodityid:               offer.Commodityid,
+		Contracttypeid:            offer.Contracttypeid,
+		Currencyid:                offer.Currencyid,
+		Deliveryplaceid:           offer.Deliveryplaceid,
+		Initprice:                 &offer.Initprice,
+		Initvolume:                &offer.Initvolume,
+		Lotsize:                   &offer.Lotsize,
+		Manufacturerid:            offer.Manufacturerid,
+		Maxinitprice:              &offer.Maxinitprice,
+		Maxincoffervol:            &offer.Maxincoffervol,
+		Maxordervol:               &offer.Maxordervol,
+		Maxofferprice:             &offer.Maxofferprice,
+		Measureunitid:             offer.Measureunitid,
+		Minallocationvol:          &offer.Minallocationvol,
+		Minoffervol:               &offer.Minoffervol,
+		Mininitprice:              &offer.Mininitprice,
+		Minordervol:               &offer.Minordervol,
+		Minofferprice:             &offer.Minofferprice,
+		Offermodeid:               offer.Offermodeid,
+		Offertypeid:               offer.Offertypeid,
+		Offervol:                  &offer.Offervol,
+		Packagingtypeid:           offer.Packagingtypeid,
+		Permissibleerror:          &offer.Permissibleerror,
+		Pricediscoveryminordervol: &offer.Pricediscoveryminordervol,
+		Prepaymentpercent:         &offer.Prepaymentpercent,
+		Securitytypeid:            &offer.Securitytypeid,
+		Settlementtypeid:          offer.Settlementtypeid,
+		Supplierid:                offer.Supplierid,
+		Ticksize:                  &offer.Ticksize,
+		Tradinghallid:             offer.Tradinghallid,
+		Weightfactor:              &offer.Weightfactor,
+		Id:                        &offer.Id,
+		Deliverydate:              offer.Deliverydate,
+		DeliverydateGregorian:     gregorian(offer.Deliverydate),
+		Description:               &offer.Description,
+		Offerdate:                 offer.Offerdate,
+		OfferdateGregorian:        gregorian(offer.Offerdate),
+		Offerring:                 &offer.Offerring,
+		Offersymbol:               &offer.Offersymbol,
+		Securitytypenote:          &offer.Securitytypenote,
+		Tradestatus:               &offer.Tradestatus,
+	}
+	if offer.Broker != nil {
+		expanded := broker.ToBrokerResponse(*offer.Broker)
+		response.Broker = &expanded
+	}
+	if offer.BuyMethod != nil {
+		expanded := buy_method.ToBuyMethodResponse(*offer.BuyMethod)
+		response.BuyMethod = &expanded
+	}
+	if offer.Commodity != nil {
+		expanded := commodity.ToCommodityResponse(*offer.Commodity)
+		response.Commodity = &expanded
+	}
+	if offer.ContractType != nil {
+		expanded := contract_type.ToContractTypeResponse(*offer.ContractType)
+		response.ContractType = &expanded
+	}
+	if offer.Currency != nil {
+		expanded := currency_unit.ToCurrencyUnitResponse(*offer.Currency)
+		response.Currency = &expanded
+	}
+	if offer.DeliveryPlace != nil {
+		expanded := delivery_place.ToDeliveryPlaceResponse(*offer.DeliveryPlace)
+		response.DeliveryPlace = &expanded
+	}
+	if offer.Manufacturer != nil {
+		expanded := manufacturers.ToManufacturersResponse(*offer.Manufacturer)
+		response.Manufacturer = &expanded
+	}
+	if offer.MeasureUnit != nil {
+		expanded := measure_unit.ToMeasureUnitResponse(*offer.MeasureUnit)
+		response.MeasureUnit = &expanded
+	}
+	if offer.OfferMode != nil {
+		expanded := offer_mod.ToOfferModResponse(*offer.OfferMode)
+		response.OfferMode = &expanded
+	}
+	if offer.OfferType != nil {
+		expanded := offer_type.ToOfferTypeResponse(*offer.OfferType)
+		response.OfferType = &expanded
+	}
+	if offer.PackagingType != nil {
+		expanded := packaging_type.ToPackagingTypeResponse(*offer.PackagingType)
+		response.PackagingType = &expanded
+	}
+	if offer.SettlementType != nil {
+		expanded := settlement.ToSettlementResponse(*offer.SettlementType)
+		response.SettlementType = &expanded
+	}
+	if offer.Supplier != nil {
+		expanded := supplier.ToSupplierResponse(*offer.Supplier)
+		response.Supplier = &expanded
+	}
+	if offer.TradingHall != nil {
+		expanded := trading_hall.ToTradingHallResponse(*offer.TradingHall)
+		response.TradingHall = &expanded
+	}
+	return response
+}
 
-
-func ToOfferResponse(buyMethod Offer) OfferResponse {
-    return OfferResponse {
-		Buymethodid: &buyMethod.Buymethodid,
-		Brokerid: &buyMethod.Brokerid,
-		Commodityid: &buyMethod.Commodityid,
-		Contracttypeid: &buyMethod.Contracttypeid,
-		Currencyid: &buyMethod.Currencyid,
-		Deliveryplaceid: &buyMethod.Deliveryplaceid,
-		Initprice: &buyMethod.Initprice,
-		Initvolume: &buyMethod.Initvolume,
-		Lotsize: &buyMethod.Lotsize,
-		Manufacturerid: &buyMethod.Manufacturerid,
-		Maxinitprice: &buyMethod.Maxinitprice,
-		Maxincoffervol: &buyMethod.Maxincoffervol,
-		Maxordervol: &buyMethod.Maxordervol,
-		Maxofferprice: &buyMethod.Maxofferprice,
-		Measureunitid: &buyMethod.Measureunitid,
-		Minallocationvol: &buyMethod.Minallocationvol,
-		Minoffervol: &buyMethod.Minoffervol,
-		Mininitprice: &buyMethod.Mininitprice,
-		Minordervol: &buyMethod.Minordervol,
-		Minofferprice: &buyMethod.Minofferprice,
-		Offermodeid: &buyMethod.Offermodeid,
-		Offertypeid: &buyMethod.Offertypeid,
-		Offervol: &buyMethod.Offervol,
-		Packagingtypeid: &buyMethod.Packagingtypeid,
-		Permissibleerror: &buyMethod.Permissibleerror,
-		Pricediscoveryminordervol: &buyMethod.Pricediscoveryminordervol,
-		Prepaymentpercent: &buyMethod.Prepaymentpercent,
-		Securitytypeid: &buyMethod.Securitytypeid,
-		Settlementtypeid: &buyMethod.Settlementtypeid,
-		Supplierid: &buyMethod.Supplierid,
-		Ticksize: &buyMethod.Ticksize,
-		Tradinghallid: &buyMethod.Tradinghallid,
-		Weightfactor: &buyMethod.Weightfactor,
-		Id: &buyMethod.Id,
-		Description: &buyMethod.Description,
-		Offerring: &buyMethod.Offerring,
-		Offersymbol: &buyMethod.Offersymbol,
-		Securitytypenote: &buyMethod.Securitytypenote,
-		Tradestatus: &buyMethod.Tradestatus,
-    }
+// gregorian renders the ISO twin of a Jalali date for clients that need it.
+func gregorian(date *jdate.Date) *string {
+	if date == nil || date.IsZero() {
+		return nil
+	}
+	value := date.Gregorian()
+	return &value
 }
@@ -1,45 +1,77 @@
 package offer
 
-import "time";type Offer struct {
-    Buymethodid int `form:"buyMethodId"`
-    Brokerid int `form:"brokerId"`
-    Commodityid int `form:"commodityId"`
-    Contracttypeid int `form:"contractTypeId"`
-    Currencyid int `form:"currencyId"`
-    Deliveryplaceid int `form:"deliveryPlaceId"`
-    Initprice int `form:"initPrice"`
-    Initvolume string `form:"initVolume"`
-    Lotsize int `form:"lotSize"`
-    Manufacturerid int `form:"manufacturerId"`
-    Maxinitprice int `form:"maxInitPrice"`
-    Maxincoffervol int `form:"maxIncOfferVol"`
-    Maxordervol int `form:"maxOrderVol"`
-    Maxofferprice int `form:"maxOfferPrice"`
-    Measureunitid int `form:"measureUnitId"`
-    Minallocationvol int `form:"minAllocationVol"`
-    Minoffervol int `form:"minOfferVol"`
-    Mininitprice int `form:"minInitPrice"`
-    Minordervol int `form:"minOrderVol"`
-    Minofferprice int `form:"minOfferPrice"`
-    Offermodeid int `form:"offerModeId"`
-    Offertypeid int `form:"offerTypeId"`
-    Offervol int `form:"offerVol"`
-    Packagingtypeid int `form:"packagingTypeId"`
-    Permissibleerror int `form:"permissibleError"`
-    Pricediscoveryminordervol int `form:"priceDiscoveryMinOrderVol"`
-    Prepaymentpercent int `form:"prepaymentPercent"`
-    Securitytypeid int `form:"securityTypeId"`
-    Settlementtypeid int `form:"settlementTypeId"`
-    Supplierid int `form:"supplierId"`
-    Ticksize int `form:"tickSize"`
-    Tradinghallid int `form:"tradingHallId"`
-    Weightfactor int `form:"weightFactor"`
-    Id            int    `form:"id" gorm:"primary_key"`
-    Deliverydate time.Time `form:"deliveryDate"`
-    Description string `form:"description"`
-    Offerdate time.Time `form:"offerDate"`
-    Offerring string `form:"offerRing"`
-    Offersymbol string `form:"offerSymbol"`
-    Securitytypenote string `form:"securityTypeNote"`
-    Tradestatus string `form:"tradeStatus"`
+import (
+	"ibrokers_service/internal/broker"
+	"ibrokers_service/internal/buy_method"
+	"ibrokers_service/internal/commodity"
+	"ibrokers_service/internal/contract_type"
+	"ibrokers_service/internal/currency_unit"
+	"ibrokers_service/internal/delivery_place"
+	"ibrokers_service/internal/manufacturers"
+	"ibrokers_service/internal/measure_unit"
+	"ibrokers_service/internal/offer_mod"
+	"ibrokers_service/internal/offer_type"
+	"ibrokers_service/internal/packaging_type"
+	"ibrokers_service/internal/settlement"
+	"ibrokers_service/internal/supplier"
+	"ibrokers_service/internal/trading_hall"
+	"ibrokers_service/pkg/jdate"
+)
+
+type Offer struct {
+	Buymethodid               *int                          `form:"buyMethodId" json:"buyMethodId" filter:"buyMethodId" ordering:"buyMethodId"`
+	BuyMethod                 *buy_method.BuyMethod         `form:"-" json:"-" gorm:"foreignKey:Buymethodid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Brokerid                  *int                          `form:"brokerId" json:"brokerId" filter:"brokerId" ordering:"brokerId"`
+	Broker                    *broker.Broker                `form:"-" json:"-" gorm:"foreignKey:Brokerid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Commodityid               *int                          `form:"commodityId" json:"commodityId" filter:"commodityId" ordering:"commodityId"`
+	Commodity                 *commodity.Commodity          `form:"-" json:"-" gorm:"foreignKey:Commodityid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Contracttypeid            *int                          `form:"contractTypeId" json:"contractTypeId" filter:"contractTypeId" ordering:"contractTypeId"`
+	ContractType              *contract_type.ContractType   `form:"-" json:"-" gorm:"foreignKey:Contracttypeid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Currencyid                *int                          `form:"currencyId" json:"currencyId" filter:"currencyId" ordering:"currencyId"`
+	Currency                  *currency_unit.CurrencyUnit   `form:"-" json:"-" gorm:"foreignKey:Currencyid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Deliveryplaceid           *int                          `form:"deliveryPlaceId" json:"deliveryPlaceId" filter:"deliveryPlaceId" ordering:"deliveryPlaceId"`
+	DeliveryPlace             *delivery_place.DeliveryPlace `form:"-" json:"-" gorm:"foreignKey:Deliveryplaceid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Initprice                 int                           `form:"initPrice" json:"initPrice" filter:"initPrice" ordering:"initPrice"`
+	Initvolume                string                        `form:"initVolume" json:"initVolume" filter:"initVolume" ordering:"initVolume"`
+	Lotsize                   int                           `form:"lotSize" json:"lotSize" filter:"lotSize" ordering:"lotSize"`
+	Manufacturerid            *int                          `form:"manufacturerId" json:"manufacturerId" filter:"manufacturerId" ordering:"manufacturerId"`
+	Manufacturer              *manufacturers.Manufacturers  `form:"-" json:"-" gorm:"foreignKey:Manufacturerid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Maxinitprice              int                           `form:"maxInitPrice" json:"maxInitPrice" filter:"maxInitPrice" ordering:"maxInitPrice"`
+	Maxincoffervol            int                           `form:"maxIncOfferVol" json:"maxIncOfferVol" filter:"maxIncOfferVol" ordering:"maxIncOfferVol"`
+	Maxordervol               int                           `form:"maxOrderVol" json:"maxOrderVol" filter:"maxOrderVol" ordering:"maxOrderVol"`
+	Maxofferprice             int                           `form:"maxOfferPrice" json:"maxOfferPrice" filter:"maxOfferPrice" ordering:"maxOfferPrice"`
+	Measureunitid             *int                          `form:"measureUnitId" json:"measureUnitId" filter:"measureUnitId" ordering:"measureUnitId"`
+	MeasureUnit               *measure_unit.MeasureUnit     `form:"-" json:"-" gorm:"foreignKey:Measureunitid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Minallocationvol          int                           `form:"minAllocationVol" json:"minAllocationVol" filter:"minAllocationVol" ordering:"minAllocationVol"`
+	Minoffervol               int                           `form:"minOfferVol" json:"minOfferVol" filter:"minOfferVol" ordering:"minOfferVol"`
+	Mininitprice              int                           `form:"minInitPrice" json:"minInitPrice" filter:"minInitPrice" ordering:"minInitPrice"`
+	Minordervol               int                           `form:"minOrderVol" json:"minOrderVol" filter:"minOrderVol" ordering:"minOrderVol"`
+	Minofferprice             int                           `form:"minOfferPrice" json:"minOfferPrice" filter:"minOfferPrice" ordering:"minOfferPrice"`
+	Offermodeid               *int                          `form:"offerModeId" json:"offerModeId" filter:"offerModeId" ordering:"offerModeId"`
+	OfferMode                 *offer_mod.OfferMod           `form:"-" json:"-" gorm:"foreignKey:Offermodeid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Offertypeid               *int                          `form:"offerTypeId" json:"offerTypeId" filter:"offerTypeId" ordering:"offerTypeId"`
+	OfferType                 *offer_type.OfferType         `form:"-" json:"-" gorm:"foreignKey:Offertypeid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Offervol                  int                           `form:"offerVol" json:"offerVol" filter:"offerVol" ordering:"offerVol"`
+	Packagingtypeid           *int                          `form:"packagingTypeId" json:"packagingTypeId" filter:"packagingTypeId" ordering:"packagingTypeId"`
+	PackagingType             *packaging_type.PackagingType `form:"-" json:"-" gorm:"foreignKey:Packagingtypeid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Permissibleerror          int                           `form:"permissibleError" json:"permissibleError" filter:"permissibleError" ordering:"permissibleError"`
+	Pricediscoveryminordervol int                           `form:"priceDiscoveryMinOrderVol" json:"priceDiscoveryMinOrderVol" filter:"priceDiscoveryMinOrderVol" ordering:"priceDiscoveryMinOrderVol"`
+	Prepaymentpercent         int                           `form:"prepaymentPercent" json:"prepaymentPercent" filter:"prepaymentPercent" ordering:"prepaymentPercent"`
+	Securitytypeid            int                           `form:"securityTypeId" json:"securityTypeId" filter:"securityTypeId" ordering:"securityTypeId"`
+	Settlementtypeid          *int                          `form:"settlementTypeId" json:"settlementTypeId" filter:"settlementTypeId" ordering:"settlementTypeId"`
+	SettlementType            *settlement.Settlement        `form:"-" json:"-" gorm:"foreignKey:Settlementtypeid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Supplierid                *int                          `form:"supplierId" json:"supplierId" filter:"supplierId" ordering:"supplierId"`
+	Supplier                  *supplier.Supplier            `form:"-" json:"-" gorm:"foreignKey:Supplierid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Ticksize                  int                           `form:"tickSize" json:"tickSize" filter:"tickSize" ordering:"tickSize"`
+	Tradinghallid             *int                          `form:"tradingHallId" json:"tradingHallId" filter:"tradingHallId" ordering:"tradingHallId"`
+	TradingHall               *trading_hall.TradingHall     `form:"-" json:"-" gorm:"foreignKey:Tradinghallid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Weightfactor              int                           `form:"weightFactor" json:"weightFactor" filter:"weightFactor" ordering:"weightFactor"`
+	Id                        int                           `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Deliverydate              *jdate.Date                   `form:"deliveryDate" json:"deliveryDate" filter:"deliveryDate" ordering:"deliveryDate"`
+	Description               string                        `form:"description" json:"description" filter:"description" ordering:"description"`
+	Offerdate                 *jdate.Date                   `form:"offerDate" json:"offerDate" filter:"offerDate" ordering:"offerDate"`
+	Offerring                 string                        `form:"offerRing" json:"offerRing" filter:"offerRing" ordering:"offerRing"`
+	Offersymbol               string                        `form:"offerSymbol" json:"offerSymbol" filter:"offerSymbol" ordering:"offerSymbol"`
+	Securitytypenote          string                        `form:"securityTypeNote" json:"securityTypeNote" filter:"securityTypeNote" ordering:"securityTypeNote"`
+	Tradestatus               string                        `form:"tradeStatus" json:"tradeStatus" filter:"tradeStatus" ordering:"tradeStatus"`
 }
@@ -0,0 +1,122 @@
+package offer
+
+import (
+	"ibrokers_service/internal/broker"
+	"ibrokers_service/internal/buy_method"
+	"ibrokers_service/internal/commodity"
+	"ibrokers_service/internal/contract_type"
+	"ibrokers_service/internal/currency_unit"
+	"ibrokers_service/internal/delivery_place"
+	"ibrokers_service/internal/manufacturers"
+	"ibrokers_service/internal/measure_unit"
+	"ibrokers_service/internal/offer_mod"
+	"ibrokers_service/internal/offer_type"
+	"ibrokers_service/internal/packaging_type"
+	"ibrokers_service/internal/settlement"
+	"ibrokers_service/internal/supplier"
+	"ibrokers_service/internal/trading_hall"
+	"ibrokers_service/pkg/crud"
+)
+
+var ErrInvalidReference = crud.ErrInvalidReference
+
+var references = []crud.Reference[Offer]{
+	{
+		Field:       "brokerId",
+		Expand:      "broker",
+		Association: "Broker",
+		Model:       &broker.Broker{},
+		Id:          func(o Offer) *int { return o.Brokerid },
+	},
+	{
+		Field:       "buyMethodId",
+		Expand:      "buy_method",
+		Association: "BuyMethod",
+		Model:       &buy_method.BuyMethod{},
+		Id:          func(o Offer) *int { return o.Buymethodid },
+	},
+	{
+		Field:       "commodityId",
+		Expand:      "commodity",
+		Association: "Commodity",
+		Model:       &commodity.Commodity{},
+		Id:          func(o Offer) *int { return o.Commodityid },
+	},
+	{
+		Field:       "contractTypeId",
+		Expand:      "contract_type",
+		Association: "ContractType",
+		Model:       &contract_type.ContractType{},
+		Id:          func(o Offer) *int { return o.Contracttypeid },
+	},
+	{
+		Field:       "currencyId",
+		Expand:      "currency",
+		Association: "Currency",
+		Model:       &currency_unit.CurrencyUnit{},
+		Id:          func(o Offer) *int { return o.Currencyid },
+	},
+	{
+		Field:       "deliveryPlaceId",
+		Expand:      "delivery_place",
+		Association: "DeliveryPlace",
+		Model:       &delivery_place.DeliveryPlace{},
+		Id:          func(o Offer) *int { return o.Deliveryplaceid },
+	},
+	{
+		Field:       "manufacturerId",
+		Expand:      "manufacturer",
+		Association: "Manufacturer",
+		Model:       &manufacturers.Manufacturers{},
+		Id:          func(o Offer) *int { return o.Manufacturerid },
+	},
+	{
+		Field:       "measureUnitId",
+		Expand:      "measure_unit",
+		Association: "MeasureUnit",
+		Model:       &measure_unit.MeasureUnit{},
+		Id:          func(o Offer) *int { return o.Measureunitid },
+	},
+	{
+		Field:       "offerModeId",
+		Expand:      "offer_mode",
+		Association: "OfferMode",
+		Model:       &offer_mod.OfferMod{},
+		Id:          func(o Offer) *int { return o.Offermodeid },
+	},
+	{
+		Field:       "offerTypeId",
+		Expand:      "offer_type",
+		Association: "OfferType",
+		Model:       &offer_type.OfferType{},
+		Id:          func(o Offer) *int { return o.Offertypeid },
+	},
+	{
+		Field:       "packagingTypeId",
+		Expand:      "packaging_type",
+		Association: "PackagingType",
+		Model:       &packaging_type.PackagingType{},
+		Id:          func(o Offer) *int { return o.Packagingtypeid },
+	},
+	{
+		Field:       "settlementTypeId",
+		Expand:      "settlement_type",
+		Association: "SettlementType",
+		Model:       &settlement.Settlement{},
+		Id:          func(o Offer) *int { return o.Settlementtypeid },
+	},
+	{
+		Field:       "supplierId",
+		Expand:      "supplier",
+		Association: "Supplier",
+		Model:       &supplier.Supplier{},
+		Id:          func(o Offer) *int { return o.Supplierid },
+	},
+	{
+		Field:       "tradingHallId",
+		Expand:      "trading_hall",
+		Association: "TradingHall",
+		Model:       &trading_hall.TradingHall{},
+		Id:          func(o Offer) *int { return o.Tradinghallid },
+	},
+}
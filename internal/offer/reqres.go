@@ -1,87 +1,123 @@
 package offer
 
-
+import (
+	"ibrokers_service/internal/broker"
+	"ibrokers_service/internal/buy_method"
+	"ibrokers_service/internal/commodity"
+	"ibrokers_service/internal/contract_type"
+	"ibrokers_service/internal/currency_unit"
+	"ibrokers_service/internal/delivery_place"
+	"ibrokers_service/internal/manufacturers"
+	"ibrokers_service/internal/measure_unit"
+	"ibrokers_service/internal/offer_mod"
+	"ibrokers_service/internal/offer_type"
+	"ibrokers_service/internal/packaging_type"
+	"ibrokers_service/internal/settlement"
+	"ibrokers_service/internal/supplier"
+	"ibrokers_service/internal/trading_hall"
+	"ibrokers_service/pkg/jdate"
+)
 
 type CreateOfferRequest struct {
-	Buymethodid *int `form:"buyMethodId"`
-	Brokerid *int `form:"brokerId"`
-	Commodityid *int `form:"commodityId"`
-	Contracttypeid *int `form:"contractTypeId"`
-	Currencyid *int `form:"currencyId"`
-	Deliveryplaceid *int `form:"deliveryPlaceId"`
-	Initprice *int `form:"initPrice"`
-	Initvolume *string `form:"initVolume"`
-	Lotsize *int `form:"lotSize"`
-	Manufacturerid *int `form:"manufacturerId"`
-	Maxinitprice *int `form:"maxInitPrice"`
-	Maxincoffervol *int `form:"maxIncOfferVol"`
-	Maxordervol *int `form:"maxOrderVol"`
-	Maxofferprice *int `form:"maxOfferPrice"`
-	Measureunitid *int `form:"measureUnitId"`
-	Minallocationvol *int `form:"minAllocationVol"`
-	Minoffervol *int `form:"minOfferVol"`
-	Mininitprice *int `form:"minInitPrice"`
-	Minordervol *int `form:"minOrderVol"`
-	Minofferprice *int `form:"minOfferPrice"`
-	Offermodeid *int `form:"offerModeId"`
-	Offertypeid *int `form:"offerTypeId"`
-	Offervol *int `form:"offerVol"`
-	Packagingtypeid *int `form:"packagingTypeId"`
-	Permissibleerror *int `form:"permissibleError"`
-	Pricediscoveryminordervol *int `form:"priceDiscoveryMinOrderVol"`
-	Prepaymentpercent *int `form:"prepaymentPercent"`
-	Securitytypeid *int `form:"securityTypeId"`
-	Settlementtypeid *int `form:"settlementTypeId"`
-	Supplierid *int `form:"supplierId"`
-	Ticksize *int `form:"tickSize"`
-	Tradinghallid *int `form:"tradingHallId"`
-	Weightfactor *int `form:"weightFactor"`
-	Id *int `form:"id"`
-	Description *string `form:"description"`
-	Offerring *string `form:"offerRing"`
-	Offersymbol *string `form:"offerSymbol"`
-	Securitytypenote *string `form:"securityTypeNote"`
-	Tradestatus *string `form:"tradeStatus"`
+	Buymethodid               *int        `form:"buyMethodId" json:"buyMethodId"`
+	Brokerid                  *int        `form:"brokerId" json:"brokerId"`
+	Commodityid               *int        `form:"commodityId" json:"commodityId"`
+	Contracttypeid            *int        `form:"contractTypeId" json:"contractTypeId"`
+	Currencyid                *int        `form:"currencyId" json:"currencyId"`
+	Deliveryplaceid           *int        `form:"deliveryPlaceId" json:"deliveryPlaceId"`
+	Initprice                 *int        `form:"initPrice" json:"initPrice"`
+	Initvolume                *string     `form:"initVolume" json:"initVolume"`
+	Lotsize                   *int        `form:"lotSize" json:"lotSize"`
+	Manufacturerid            *int        `form:"manufacturerId" json:"manufacturerId"`
+	Maxinitprice              *int        `form:"maxInitPrice" json:"maxInitPrice"`
+	Maxincoffervol            *int        `form:"maxIncOfferVol" json:"maxIncOfferVol"`
+	Maxordervol               *int        `form:"maxOrderVol" json:"maxOrderVol"`
+	Maxofferprice             *int        `form:"maxOfferPrice" json:"maxOfferPrice"`
+	Measureunitid             *int        `form:"measureUnitId" json:"measureUnitId"`
+	Minallocationvol          *int        `form:"minAllocationVol" json:"minAllocationVol"`
+	Minoffervol               *int        `form:"minOfferVol" json:"minOfferVol"`
+	Mininitprice              *int        `form:"minInitPrice" json:"minInitPrice"`
+	Minordervol               *int        `form:"minOrderVol" json:"minOrderVol"`
+	Minofferprice             *int        `form:"minOfferPrice" json:"minOfferPrice"`
+	Offermodeid               *int        `form:"offerModeId" json:"offerModeId"`
+	Offertypeid               *int        `form:"offerTypeId" json:"offerTypeId"`
+	Offervol                  *int        `form:"offerVol" json:"offerVol"`
+	Packagingtypeid           *int        `form:"packagingTypeId" json:"packagingTypeId"`
+	Permissibleerror          *int        `form:"permissibleError" json:"permissibleError"`
+	Pricediscoveryminordervol *int        `form:"priceDiscoveryMinOrderVol" json:"priceDiscoveryMinOrderVol"`
+	Prepaymentpercent         *int        `form:"prepaymentPercent" json:"prepaymentPercent"`
+	Securitytypeid            *int        `form:"securityTypeId" json:"securityTypeId"`
+	Settlementtypeid          *int        `form:"settlementTypeId" json:"settlementTypeId"`
+	Supplierid                *int        `form:"supplierId" json:"supplierId"`
+	Ticksize                  *int        `form:"tickSize" json:"tickSize"`
+	Tradinghallid             *int        `form:"tradingHallId" json:"tradingHallId"`
+	Weightfactor              *int        `form:"weightFactor" json:"weightFactor"`
+	Id                        *int        `form:"id" json:"id"`
+	Deliverydate              *jdate.Date `form:"deliveryDate" json:"deliveryDate"`
+	Description               *string     `form:"description" json:"description"`
+	Offerdate                 *jdate.Date `form:"offerDate" json:"offerDate"`
+	Offerring                 *string     `form:"offerRing" json:"offerRing"`
+	Offersymbol               *string     `form:"offerSymbol" json:"offerSymbol"`
+	Securitytypenote          *string     `form:"securityTypeNote" json:"securityTypeNote"`
+	Tradestatus               *string     `form:"tradeStatus" json:"tradeStatus"`
 }
 
 type OfferResponse struct {
-    	Buymethodid *int `form:"buyMethodId"`
-	Brokerid *int `form:"brokerId"`
-	Commodityid *int `form:"commodityId"`
-	Contracttypeid *int `form:"contractTypeId"`
-	Currencyid *int `form:"currencyId"`
-	Deliveryplaceid *int `form:"deliveryPlaceId"`
-	Initprice *int `form:"initPrice"`
-	Initvolume *string `form:"initVolume"`
-	Lotsize *int `form:"lotSize"`
-	Manufacturerid *int `form:"manufacturerId"`
-	Maxinitprice *int `form:"maxInitPrice"`
-	Maxincoffervol *int `form:"maxIncOfferVol"`
-	Maxordervol *int `form:"maxOrderVol"`
-	Maxofferprice *int `form:"maxOfferPrice"`
-	Measureunitid *int `form:"measureUnitId"`
-	Minallocationvol *int `form:"minAllocationVol"`
-	Minoffervol *int `form:"minOfferVol"`
-	Mininitprice *int `form:"minInitPrice"`
-	Minordervol *int `form:"minOrderVol"`
-	Minofferprice *int `form:"minOfferPrice"`
-	Offermodeid *int `form:"offerModeId"`
-	Offertypeid *int `form:"offerTypeId"`
-	Offervol *int `form:"offerVol"`
-	Packagingtypeid *int `form:"packagingTypeId"`
-	Permissibleerror *int `form:"permissibleError"`
-	Pricediscoveryminordervol *int `form:"priceDiscoveryMinOrderVol"`
-	Prepaymentpercent *int `form:"prepaymentPercent"`
-	Securitytypeid *int `form:"securityTypeId"`
-	Settlementtypeid *int `form:"settlementTypeId"`
-	Supplierid *int `form:"supplierId"`
-	Ticksize *int `form:"tickSize"`
-	Tradinghallid *int `form:"tradingHallId"`
-	Weightfactor *int `form:"weightFactor"`
-	Id *int `form:"id"`
-	Description *string `form:"description"`
-	Offerring *string `form:"offerRing"`
-	Offersymbol *string `form:"offerSymbol"`
-	Securitytypenote *string `form:"securityTypeNote"`
-	Tradestatus *string `form:"tradeStatus"`
+	Buymethodid               *int                                  `form:"buyMethodId" json:"buyMethodId"`
+	BuyMethod                 *buy_method.BuyMethodResponse         `json:"buyMethod,omitempty"`
+	Brokerid                  *int                                  `form:"brokerId" json:"brokerId"`
+	Broker                    *broker.BrokerResponse                `json:"broker,omitempty"`
+	Commodityid               *int                                  `form:"commodityId" json:"commodityId"`
+	Commodity                 *commodity.CommodityResponse          `json:"commodity,omitempty"`
+	Contracttypeid            *int                                  `form:"contractTypeId" json:"contractTypeId"`
+	ContractType              *contract_type.ContractTypeResponse   `json:"contractType,omitempty"`
+	Currencyid                *int                                  `form:"currencyId" json:"currencyId"`
+	Currency                  *currency_unit.CurrencyUnitResponse   `json:"currency,omitempty"`
+	Deliveryplaceid           *int                                  `form:"deliveryPlaceId" json:"deliveryPlaceId"`
+	DeliveryPlace             *delivery_place.DeliveryPlaceResponse `json:"deliveryPlace,omitempty"`
+	Initprice                 *int                                  `form:"initPrice" json:"initPrice"`
+	Initvolume                *string                               `form:"initVolume" json:"initVolume"`
+	Lotsize                   *int                                  `form:"lotSize" json:"lotSize"`
+	Manufacturerid            *int                                  `form:"manufacturerId" json:"manufacturerId"`
+	Manufacturer              *manufacturers.ManufacturersResponse  `json:"manufacturer,omitempty"`
+	Maxinitprice              *int                                  `form:"maxInitPrice" json:"maxInitPrice"`
+	Maxincoffervol            *int                                  `form:"maxIncOfferVol" json:"maxIncOfferVol"`
+	Maxordervol               *int                                  `form:"maxOrderVol" json:"maxOrderVol"`
+	Maxofferprice             *int                                  `form:"maxOfferPrice" json:"maxOfferPrice"`
+	Measureunitid             *int                                  `form:"measureUnitId" json:"measureUnitId"`
+	MeasureUnit               *measure_unit.MeasureUnitResponse     `json:"measureUnit,omitempty"`
+	Minallocationvol          *int                                  `form:"minAllocationVol" json:"minAllocationVol"`
+	Minoffervol               *int                                  `form:"minOfferVol" json:"minOfferVol"`
+	Mininitprice              *int                                  `form:"minInitPrice" json:"minInitPrice"`
+	Minordervol               *int                                  `form:"minOrderVol" json:"minOrderVol"`
+	Minofferprice             *int                                  `form:"minOfferPrice" json:"minOfferPrice"`
+	Offermodeid               *int                                  `form:"offerModeId" json:"offerModeId"`
+	OfferMode                 *offer_mod.OfferModResponse           `json:"offerMode,omitempty"`
+	Offertypeid               *int                                  `form:"offerTypeId" json:"offerTypeId"`
+	OfferType                 *offer_type.OfferTypeResponse         `json:"offerType,omitempty"`
+	Offervol                  *int                                  `form:"offerVol" json:"offerVol"`
+	Packagingtypeid           *int                                  `form:"packagingTypeId" json:"packagingTypeId"`
+	PackagingType             *packaging_type.PackagingTypeResponse `json:"packagingType,omitempty"`
+	Permissibleerror          *int                                  `form:"permissibleError" json:"permissibleError"`
+	Pricediscoveryminordervol *int                                  `form:"priceDiscoveryMinOrderVol" json:"priceDiscoveryMinOrderVol"`
+	Prepaymentpercent         *int                                  `form:"prepaymentPercent" json:"prepaymentPercent"`
+	Securitytypeid            *int                                  `form:"securityTypeId" json:"securityTypeId"`
+	Settlementtypeid          *int                                  `form:"settlementTypeId" json:"settlementTypeId"`
+	SettlementType            *settlement.SettlementResponse        `json:"settlementType,omitempty"`
+	Supplierid                *int                                  `form:"supplierId" json:"supplierId"`
+	Supplier                  *supplier.SupplierResponse            `json:"supplier,omitempty"`
+	Ticksize                  *int                                  `form:"tickSize" json:"tickSize"`
+	Tradinghallid             *int                                  `form:"tradingHallId" json:"tradingHallId"`
+	TradingHall               *trading_hall.TradingHallResponse     `json:"tradingHall,omitempty"`
+	Weightfactor              *int                                  `form:"weightFactor" json:"weightFactor"`
+	Id                        *int                                  `form:"id" json:"id"`
+	Deliverydate              *jdate.Date                           `form:"deliveryDate" json:"deliveryDate"`
+	DeliverydateGregorian     *string                               `json:"deliveryDateGregorian,omitempty"`
+	Description               *string                               `form:"description" json:"description"`
+	Offerdate                 *jdate.Date                           `form:"offerDate" json:"offerDate"`
+	OfferdateGregorian        *string                               `json:"offerDateGregorian,omitempty"`
+	Offerring                 *string                               `form:"offerRing" json:"offerRing"`
+	Offersymbol               *string                               `form:"offerSymbol" json:"offerSymbol"`
+	Securitytypenote          *string                               `form:"securityTypeNote" json:"securityTypeNote"`
+	Tradestatus               *string                               `form:"tradeStatus" json:"tradeStatus"`
 }
@@ -1,27 +1,12 @@
 package offer
 
-import "ibrokers_service/pkg/middleware/filter/operators"
+import "ibrokers_service/pkg/crud"
 
-type Service struct {
-    Repository Repository
-}
-
-func (s *Service) CreateOffer(item Offer) (Offer, error) {
-    return s.Repository.CreateOffer(item)
-}
-
-func (s *Service) UpdateOffer(item Offer) error {
-    _, err := s.Repository.FindOfferById(item.Id)
-    if err != nil {
-        return err
-    }
-    return s.Repository.UpdateOffer(item)
-}
-
-func (s *Service) DeleteOffer(item Offer) error {
-    return s.Repository.DeleteOffer(item)
-}
+type Service = crud.Service[Offer]
 
-func (s *Service) GetAllOffers(limit, page int, filters []operators.FilterBlock) ([]Offer, int64) {
-    return s.Repository.GetAllOffers(limit, page, filters)
+func NewService(rep Repository) Service {
+	return Service{
+		Repository: rep,
+		Validate:   crud.CheckReferences(rep, references),
+	}
 }
@@ -0,0 +1,102 @@
+package offer
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// offer routes live here; make docs reads them with --parseFuncBody.
+
+// ListOffer godoc
+// @Summary      List offer
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         offer
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]OfferResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer/api/v1/ [get]
+
+// SearchOffer godoc
+// @Summary      Search offer
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         offer
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]OfferResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer/api/v1/search/ [post]
+
+// GetOffer godoc
+// @Summary      Get offer by id
+// @Tags         offer
+// @Produce      json
+// @Param        id      path   int     true   "Offer id"
+// @Param        expand  query  string  false  "Comma separated associations to embed: broker, buy_method, commodity, contract_type, currency, delivery_place, manufacturer, measure_unit, offer_mode, offer_type, packaging_type, settlement_type, supplier, trading_hall"
+// @Success      200  {object}  OfferResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer/api/v1/{id}/ [get]
+
+// CreateOffer godoc
+// @Summary      Create offer
+// @Tags         offer
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateOfferRequest  true  "Offer"
+// @Success      201  {object}  OfferResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer/api/v1/ [post]
+
+// ReplaceOffer godoc
+// @Summary      Replace offer
+// @Description  Fields left out of the body are cleared.
+// @Tags         offer
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Offer id"
+// @Param        body  body  CreateOfferRequest  true  "Offer"
+// @Success      200  {object}  OfferResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer/api/v1/{id}/ [put]
+
+// UpdateOffer godoc
+// @Summary      Update offer fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         offer
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Offer id"
+// @Param        body  body  CreateOfferRequest  true  "Fields to change"
+// @Success      200  {object}  OfferResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer/api/v1/{id}/ [patch]
+
+// DeleteOffer godoc
+// @Summary      Delete offer
+// @Tags         offer
+// @Param        id  path  int  true  "Offer id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer/api/v1/{id}/ [delete]
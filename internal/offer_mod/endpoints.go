@@ -0,0 +1,19 @@
+package offer_mod
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[OfferMod, CreateOfferModRequest, OfferModResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "offer mod",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToOfferModResponse,
+	})
+}
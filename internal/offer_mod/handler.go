@@ -0,0 +1,9 @@
+package offer_mod
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "offer_mod"
+
+var ErrOfferModNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[OfferMod, CreateOfferModRequest, OfferModResponse]
@@ -0,0 +1,9 @@
+package offer_mod
+
+func ToOfferModResponse(offerMod OfferMod) OfferModResponse {
+	return OfferModResponse{
+		Id:          &offerMod.Id,
+		Description: &offerMod.Description,
+		Persianname: &offerMod.Persianname,
+	}
+}
@@ -0,0 +1,5 @@
+package offer_mod
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[OfferMod]
@@ -0,0 +1,101 @@
+package offer_mod
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// offer mod routes live here; make docs reads them with --parseFuncBody.
+
+// ListOfferMod godoc
+// @Summary      List offer mod
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         offer mod
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]OfferModResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-mod/api/v1/ [get]
+
+// SearchOfferMod godoc
+// @Summary      Search offer mod
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         offer mod
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]OfferModResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-mod/api/v1/search/ [post]
+
+// GetOfferMod godoc
+// @Summary      Get offer mod by id
+// @Tags         offer mod
+// @Produce      json
+// @Param        id      path   int     true   "OfferMod id"
+// @Success      200  {object}  OfferModResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-mod/api/v1/{id}/ [get]
+
+// CreateOfferMod godoc
+// @Summary      Create offer mod
+// @Tags         offer mod
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateOfferModRequest  true  "OfferMod"
+// @Success      201  {object}  OfferModResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-mod/api/v1/ [post]
+
+// ReplaceOfferMod godoc
+// @Summary      Replace offer mod
+// @Description  Fields left out of the body are cleared.
+// @Tags         offer mod
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "OfferMod id"
+// @Param        body  body  CreateOfferModRequest  true  "OfferMod"
+// @Success      200  {object}  OfferModResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-mod/api/v1/{id}/ [put]
+
+// UpdateOfferMod godoc
+// @Summary      Update offer mod fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         offer mod
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "OfferMod id"
+// @Param        body  body  CreateOfferModRequest  true  "Fields to change"
+// @Success      200  {object}  OfferModResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-mod/api/v1/{id}/ [patch]
+
+// DeleteOfferMod godoc
+// @Summary      Delete offer mod
+// @Tags         offer mod
+// @Param        id  path  int  true  "OfferMod id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-mod/api/v1/{id}/ [delete]
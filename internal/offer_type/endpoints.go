@@ -0,0 +1,19 @@
+package offer_type
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[OfferType, CreateOfferTypeRequest, OfferTypeResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "offer type",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToOfferTypeResponse,
+	})
+}
@@ -0,0 +1,9 @@
+package offer_type
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "offer_type"
+
+var ErrOfferTypeNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[OfferType, CreateOfferTypeRequest, OfferTypeResponse]
@@ -0,0 +1,9 @@
+package offer_type
+
+func ToOfferTypeResponse(offerType OfferType) OfferTypeResponse {
+	return OfferTypeResponse{
+		Id:          &offerType.Id,
+		Description: &offerType.Description,
+		Persianname: &offerType.Persianname,
+	}
+}
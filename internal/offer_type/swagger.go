@@ -0,0 +1,101 @@
+package offer_type
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// offer type routes live here; make docs reads them with --parseFuncBody.
+
+// ListOfferType godoc
+// @Summary      List offer type
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         offer type
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]OfferTypeResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-type/api/v1/ [get]
+
+// SearchOfferType godoc
+// @Summary      Search offer type
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         offer type
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]OfferTypeResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-type/api/v1/search/ [post]
+
+// GetOfferType godoc
+// @Summary      Get offer type by id
+// @Tags         offer type
+// @Produce      json
+// @Param        id      path   int     true   "OfferType id"
+// @Success      200  {object}  OfferTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-type/api/v1/{id}/ [get]
+
+// CreateOfferType godoc
+// @Summary      Create offer type
+// @Tags         offer type
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateOfferTypeRequest  true  "OfferType"
+// @Success      201  {object}  OfferTypeResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-type/api/v1/ [post]
+
+// ReplaceOfferType godoc
+// @Summary      Replace offer type
+// @Description  Fields left out of the body are cleared.
+// @Tags         offer type
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "OfferType id"
+// @Param        body  body  CreateOfferTypeRequest  true  "OfferType"
+// @Success      200  {object}  OfferTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-type/api/v1/{id}/ [put]
+
+// UpdateOfferType godoc
+// @Summary      Update offer type fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         offer type
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "OfferType id"
+// @Param        body  body  CreateOfferTypeRequest  true  "Fields to change"
+// @Success      200  {object}  OfferTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-type/api/v1/{id}/ [patch]
+
+// DeleteOfferType godoc
+// @Summary      Delete offer type
+// @Tags         offer type
+// @Param        id  path  int  true  "OfferType id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /offer-type/api/v1/{id}/ [delete]
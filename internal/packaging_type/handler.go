@@ -0,0 +1,9 @@
+package packaging_type
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "packaging_type"
+
+var ErrPackagingTypeNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[PackagingType, CreatePackagingTypeRequest, PackagingTypeResponse]
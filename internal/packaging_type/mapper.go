@@ -0,0 +1,9 @@
+package packaging_type
+
+func ToPackagingTypeResponse(packagingType PackagingType) PackagingTypeResponse {
+	return PackagingTypeResponse{
+		Id:          &packagingType.Id,
+		Description: &packagingType.Description,
+		Persianname: &packagingType.Persianname,
+	}
+}
@@ -0,0 +1,5 @@
+package packaging_type
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[PackagingType]
@@ -0,0 +1,5 @@
+package packaging_type
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[PackagingType]
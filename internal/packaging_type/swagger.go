@@ -0,0 +1,101 @@
+package packaging_type
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// packaging type routes live here; make docs reads them with --parseFuncBody.
+
+// ListPackagingType godoc
+// @Summary      List packaging type
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         packaging type
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]PackagingTypeResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /packaging-type/api/v1/ [get]
+
+// SearchPackagingType godoc
+// @Summary      Search packaging type
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         packaging type
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]PackagingTypeResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /packaging-type/api/v1/search/ [post]
+
+// GetPackagingType godoc
+// @Summary      Get packaging type by id
+// @Tags         packaging type
+// @Produce      json
+// @Param        id      path   int     true   "PackagingType id"
+// @Success      200  {object}  PackagingTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /packaging-type/api/v1/{id}/ [get]
+
+// CreatePackagingType godoc
+// @Summary      Create packaging type
+// @Tags         packaging type
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreatePackagingTypeRequest  true  "PackagingType"
+// @Success      201  {object}  PackagingTypeResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /packaging-type/api/v1/ [post]
+
+// ReplacePackagingType godoc
+// @Summary      Replace packaging type
+// @Description  Fields left out of the body are cleared.
+// @Tags         packaging type
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "PackagingType id"
+// @Param        body  body  CreatePackagingTypeRequest  true  "PackagingType"
+// @Success      200  {object}  PackagingTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /packaging-type/api/v1/{id}/ [put]
+
+// UpdatePackagingType godoc
+// @Summary      Update packaging type fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         packaging type
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "PackagingType id"
+// @Param        body  body  CreatePackagingTypeRequest  true  "Fields to change"
+// @Success      200  {object}  PackagingTypeResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /packaging-type/api/v1/{id}/ [patch]
+
+// DeletePackagingType godoc
+// @Summary      Delete packaging type
+// @Tags         packaging type
+// @Param        id  path  int  true  "PackagingType id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /packaging-type/api/v1/{id}/ [delete]
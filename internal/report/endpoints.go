@@ -0,0 +1,19 @@
+package report
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[Report, CreateReportRequest, ReportResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "report",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToReportResponse,
+	})
+}
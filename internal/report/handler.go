@@ -0,0 +1,9 @@
+package report
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "report"
+
+var ErrReportNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[Report, CreateReportRequest, ReportResponse]
@@ -0,0 +1,9 @@
+package report
+
+func ToReportResponse(report Report) ReportResponse {
+	return ReportResponse{
+		Id:          &report.Id,
+		Description: &report.Description,
+		Persianname: &report.Persianname,
+	}
+}
@@ -0,0 +1,5 @@
+package report
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[Report]
@@ -0,0 +1,5 @@
+package report
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[Report]
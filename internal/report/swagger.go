@@ -0,0 +1,101 @@
+package report
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// report routes live here; make docs reads them with --parseFuncBody.
+
+// ListReport godoc
+// @Summary      List report
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         report
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]ReportResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /report/api/v1/ [get]
+
+// SearchReport godoc
+// @Summary      Search report
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         report
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]ReportResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /report/api/v1/search/ [post]
+
+// GetReport godoc
+// @Summary      Get report by id
+// @Tags         report
+// @Produce      json
+// @Param        id      path   int     true   "Report id"
+// @Success      200  {object}  ReportResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /report/api/v1/{id}/ [get]
+
+// CreateReport godoc
+// @Summary      Create report
+// @Tags         report
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateReportRequest  true  "Report"
+// @Success      201  {object}  ReportResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /report/api/v1/ [post]
+
+// ReplaceReport godoc
+// @Summary      Replace report
+// @Description  Fields left out of the body are cleared.
+// @Tags         report
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Report id"
+// @Param        body  body  CreateReportRequest  true  "Report"
+// @Success      200  {object}  ReportResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /report/api/v1/{id}/ [put]
+
+// UpdateReport godoc
+// @Summary      Update report fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         report
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Report id"
+// @Param        body  body  CreateReportRequest  true  "Fields to change"
+// @Success      200  {object}  ReportResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /report/api/v1/{id}/ [patch]
+
+// DeleteReport godoc
+// @Summary      Delete report
+// @Tags         report
+// @Param        id  path  int  true  "Report id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /report/api/v1/{id}/ [delete]
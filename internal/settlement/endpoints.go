@@ -0,0 +1,19 @@
+package settlement
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[Settlement, CreateSettlementRequest, SettlementResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "settlement",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToSettlementResponse,
+	})
+}
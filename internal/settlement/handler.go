@@ -0,0 +1,9 @@
+package settlement
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "settlement"
+
+var ErrSettlementNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[Settlement, CreateSettlementRequest, SettlementResponse]
@@ -0,0 +1,9 @@
+package settlement
+
+func ToSettlementResponse(settlement Settlement) SettlementResponse {
+	return SettlementResponse{
+		Id:          &settlement.Id,
+		Description: &settlement.Description,
+		Persianname: &settlement.Persianname,
+	}
+}
@@ -0,0 +1,5 @@
+package settlement
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[Settlement]
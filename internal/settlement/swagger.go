@@ -0,0 +1,101 @@
+package settlement
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// settlement routes live here; make docs reads them with --parseFuncBody.
+
+// ListSettlement godoc
+// @Summary      List settlement
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         settlement
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]SettlementResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /settlement/api/v1/ [get]
+
+// SearchSettlement godoc
+// @Summary      Search settlement
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         settlement
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]SettlementResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /settlement/api/v1/search/ [post]
+
+// GetSettlement godoc
+// @Summary      Get settlement by id
+// @Tags         settlement
+// @Produce      json
+// @Param        id      path   int     true   "Settlement id"
+// @Success      200  {object}  SettlementResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /settlement/api/v1/{id}/ [get]
+
+// CreateSettlement godoc
+// @Summary      Create settlement
+// @Tags         settlement
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateSettlementRequest  true  "Settlement"
+// @Success      201  {object}  SettlementResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /settlement/api/v1/ [post]
+
+// ReplaceSettlement godoc
+// @Summary      Replace settlement
+// @Description  Fields left out of the body are cleared.
+// @Tags         settlement
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Settlement id"
+// @Param        body  body  CreateSettlementRequest  true  "Settlement"
+// @Success      200  {object}  SettlementResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /settlement/api/v1/{id}/ [put]
+
+// UpdateSettlement godoc
+// @Summary      Update settlement fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         settlement
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Settlement id"
+// @Param        body  body  CreateSettlementRequest  true  "Fields to change"
+// @Success      200  {object}  SettlementResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /settlement/api/v1/{id}/ [patch]
+
+// DeleteSettlement godoc
+// @Summary      Delete settlement
+// @Tags         settlement
+// @Param        id  path  int  true  "Settlement id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /settlement/api/v1/{id}/ [delete]
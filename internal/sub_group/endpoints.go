@@ -0,0 +1,20 @@
+package sub_group
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[SubGroup, CreateSubGroupRequest, SubGroupResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "sub group",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToSubGroupResponse,
+		Expand:      crud.ExpandMap(references),
+	})
+}
@@ -0,0 +1,9 @@
+package sub_group
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "sub_group"
+
+var ErrSubGroupNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[SubGroup, CreateSubGroupRequest, SubGroupResponse]
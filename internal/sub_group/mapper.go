@@ -0,0 +1,19 @@
+package sub_group
+
+import (
+	"ibrokers_service/internal/group"
+)
+
+func ToSubGroupResponse(subGroup SubGroup) SubGroupResponse {
+	response := SubGroupResponse{
+		Id:          &subGroup.Id,
+		Description: &subGroup.Description,
+		Persianname: &subGroup.Persianname,
+		Groupid:     subGroup.Groupid,
+	}
+	if subGroup.Group != nil {
+		expanded := group.ToGroupResponse(*subGroup.Group)
+		response.Group = &expanded
+	}
+	return response
+}
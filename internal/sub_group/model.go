@@ -0,0 +1,13 @@
+package sub_group
+
+import (
+	"ibrokers_service/internal/group"
+)
+
+type SubGroup struct {
+	Id          int          `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description string       `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname string       `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+	Groupid     *int         `form:"groupId" json:"groupId" filter:"groupId" ordering:"groupId" gorm:"index"`
+	Group       *group.Group `form:"-" json:"-" gorm:"foreignKey:Groupid;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
@@ -0,0 +1,16 @@
+package sub_group
+
+import (
+	"ibrokers_service/internal/group"
+	"ibrokers_service/pkg/crud"
+)
+
+var references = []crud.Reference[SubGroup]{
+	{
+		Field:       "groupId",
+		Expand:      "group",
+		Association: "Group",
+		Model:       &group.Group{},
+		Id:          func(s SubGroup) *int { return s.Groupid },
+	},
+}
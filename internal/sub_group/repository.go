@@ -0,0 +1,5 @@
+package sub_group
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[SubGroup]
@@ -0,0 +1,20 @@
+package sub_group
+
+import (
+	"ibrokers_service/internal/group"
+)
+
+type CreateSubGroupRequest struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+	Groupid     *int    `form:"groupId" json:"groupId"`
+}
+
+type SubGroupResponse struct {
+	Id          *int                 `form:"id" json:"id"`
+	Description *string              `form:"description" json:"description"`
+	Persianname *string              `form:"persianName" json:"persianName"`
+	Groupid     *int                 `form:"groupId" json:"groupId"`
+	Group       *group.GroupResponse `json:"group,omitempty"`
+}
@@ -0,0 +1,12 @@
+package sub_group
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[SubGroup]
+
+func NewService(rep Repository) Service {
+	return Service{
+		Repository: rep,
+		Validate:   crud.CheckReferences(rep, references),
+	}
+}
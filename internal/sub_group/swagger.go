@@ -0,0 +1,102 @@
+package sub_group
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// sub group routes live here; make docs reads them with --parseFuncBody.
+
+// ListSubGroup godoc
+// @Summary      List sub group
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         sub group
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]SubGroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /sub-group/api/v1/ [get]
+
+// SearchSubGroup godoc
+// @Summary      Search sub group
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         sub group
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]SubGroupResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /sub-group/api/v1/search/ [post]
+
+// GetSubGroup godoc
+// @Summary      Get sub group by id
+// @Tags         sub group
+// @Produce      json
+// @Param        id      path   int     true   "SubGroup id"
+// @Param        expand  query  string  false  "Comma separated associations to embed: group"
+// @Success      200  {object}  SubGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /sub-group/api/v1/{id}/ [get]
+
+// CreateSubGroup godoc
+// @Summary      Create sub group
+// @Tags         sub group
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateSubGroupRequest  true  "SubGroup"
+// @Success      201  {object}  SubGroupResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /sub-group/api/v1/ [post]
+
+// ReplaceSubGroup godoc
+// @Summary      Replace sub group
+// @Description  Fields left out of the body are cleared.
+// @Tags         sub group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "SubGroup id"
+// @Param        body  body  CreateSubGroupRequest  true  "SubGroup"
+// @Success      200  {object}  SubGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /sub-group/api/v1/{id}/ [put]
+
+// UpdateSubGroup godoc
+// @Summary      Update sub group fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         sub group
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "SubGroup id"
+// @Param        body  body  CreateSubGroupRequest  true  "Fields to change"
+// @Success      200  {object}  SubGroupResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /sub-group/api/v1/{id}/ [patch]
+
+// DeleteSubGroup godoc
+// @Summary      Delete sub group
+// @Tags         sub group
+// @Param        id  path  int  true  "SubGroup id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /sub-group/api/v1/{id}/ [delete]
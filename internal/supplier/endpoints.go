@@ -0,0 +1,19 @@
+package supplier
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[Supplier, CreateSupplierRequest, SupplierResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "supplier",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToSupplierResponse,
+	})
+}
@@ -0,0 +1,9 @@
+package supplier
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "supplier"
+
+var ErrSupplierNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[Supplier, CreateSupplierRequest, SupplierResponse]
@@ -0,0 +1,10 @@
+package supplier
+
+func ToSupplierResponse(supplier Supplier) SupplierResponse {
+	return SupplierResponse{
+		Id:          &supplier.Id,
+		Description: &supplier.Description,
+		Persianname: &supplier.Persianname,
+		Nationalid:  &supplier.Nationalid,
+	}
+}
@@ -0,0 +1,8 @@
+package supplier
+
+type Supplier struct {
+	Id          int    `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Description string `form:"description" json:"description" filter:"description" ordering:"description"`
+	Persianname string `form:"persianName" json:"persianName" filter:"persianName" ordering:"persianName"`
+	Nationalid  string `form:"nationalId" json:"nationalId" filter:"nationalId" ordering:"nationalId"`
+}
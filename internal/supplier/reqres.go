@@ -0,0 +1,15 @@
+package supplier
+
+type CreateSupplierRequest struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+	Nationalid  *string `form:"nationalId" json:"nationalId"`
+}
+
+type SupplierResponse struct {
+	Id          *int    `form:"id" json:"id"`
+	Description *string `form:"description" json:"description"`
+	Persianname *string `form:"persianName" json:"persianName"`
+	Nationalid  *string `form:"nationalId" json:"nationalId"`
+}
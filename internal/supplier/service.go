@@ -0,0 +1,5 @@
+package supplier
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[Supplier]
@@ -0,0 +1,101 @@
+package supplier
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// supplier routes live here; make docs reads them with --parseFuncBody.
+
+// ListSupplier godoc
+// @Summary      List supplier
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         supplier
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]SupplierResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /supplier/api/v1/ [get]
+
+// SearchSupplier godoc
+// @Summary      Search supplier
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         supplier
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]SupplierResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /supplier/api/v1/search/ [post]
+
+// GetSupplier godoc
+// @Summary      Get supplier by id
+// @Tags         supplier
+// @Produce      json
+// @Param        id      path   int     true   "Supplier id"
+// @Success      200  {object}  SupplierResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /supplier/api/v1/{id}/ [get]
+
+// CreateSupplier godoc
+// @Summary      Create supplier
+// @Tags         supplier
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateSupplierRequest  true  "Supplier"
+// @Success      201  {object}  SupplierResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /supplier/api/v1/ [post]
+
+// ReplaceSupplier godoc
+// @Summary      Replace supplier
+// @Description  Fields left out of the body are cleared.
+// @Tags         supplier
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Supplier id"
+// @Param        body  body  CreateSupplierRequest  true  "Supplier"
+// @Success      200  {object}  SupplierResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /supplier/api/v1/{id}/ [put]
+
+// UpdateSupplier godoc
+// @Summary      Update supplier fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         supplier
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "Supplier id"
+// @Param        body  body  CreateSupplierRequest  true  "Fields to change"
+// @Success      200  {object}  SupplierResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /supplier/api/v1/{id}/ [patch]
+
+// DeleteSupplier godoc
+// @Summary      Delete supplier
+// @Tags         supplier
+// @Param        id  path  int  true  "Supplier id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /supplier/api/v1/{id}/ [delete]
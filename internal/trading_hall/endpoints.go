@@ -0,0 +1,19 @@
+package trading_hall
+
+import (
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/utils/manager"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Endpoints = crud.Resource[TradingHall, CreateTradingHallRequest, TradingHallResponse]
+
+func CreateEndpoint(s Service, router *gin.RouterGroup, fileManager manager.FileManager) *Endpoints {
+	return crud.NewResource(router, Handler{
+		Name:        "trading hall",
+		Service:     s,
+		FileManager: fileManager,
+		ToResponse:  ToTradingHallResponse,
+	})
+}
@@ -0,0 +1,9 @@
+package trading_hall
+
+import "ibrokers_service/pkg/crud"
+
+const BucketName = "trading_hall"
+
+var ErrTradingHallNotFound = crud.ErrNotFound
+
+type Handler = crud.Handler[TradingHall, CreateTradingHallRequest, TradingHallResponse]
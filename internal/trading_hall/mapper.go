@@ -0,0 +1,9 @@
+package trading_hall
+
+func ToTradingHallResponse(tradingHall TradingHall) TradingHallResponse {
+	return TradingHallResponse{
+		Id:          &tradingHall.Id,
+		Description: &tradingHall.Description,
+		Persianname: &tradingHall.Persianname,
+	}
+}
@@ -0,0 +1,5 @@
+package trading_hall
+
+import "ibrokers_service/pkg/crud"
+
+type Repository = crud.Repository[TradingHall]
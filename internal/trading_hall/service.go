@@ -0,0 +1,5 @@
+package trading_hall
+
+import "ibrokers_service/pkg/crud"
+
+type Service = crud.Service[TradingHall]
@@ -0,0 +1,101 @@
+package trading_hall
+
+// The handlers of crud.Resource are generic, so the swag annotations of the
+// trading hall routes live here; make docs reads them with --parseFuncBody.
+
+// ListTradingHall godoc
+// @Summary      List trading hall
+// @Description  Filter with field or field__operator parameters, or a boolean ?filter= expression.
+// @Description  ?cursor= switches to keyset pagination: the pagination object then holds next_cursor and prev_cursor.
+// @Tags         trading hall
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        filter      query  string  false  "Boolean filter expression, e.g. (a:1|a:2),b__gte:3"
+// @Success      200  {object}  pagination.Response{data=[]TradingHallResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /trading-hall/api/v1/ [get]
+
+// SearchTradingHall godoc
+// @Summary      Search trading hall
+// @Description  Lists like GET with the filter tree in the body. Pagination and ordering stay query parameters.
+// @Tags         trading hall
+// @Accept       json
+// @Produce      json
+// @Param        page        query  int     false  "Page number"
+// @Param        limit       query  int     false  "Page size"
+// @Param        cursor      query  string  false  "Keyset cursor, empty for the first page"
+// @Param        with_count  query  bool    false  "Count the rows in keyset mode"
+// @Param        ordering    query  string  false  "Comma separated fields, - for descending"
+// @Param        body        body   filter.SearchRequest  true  "Filter tree"
+// @Success      200  {object}  pagination.Response{data=[]TradingHallResponse}
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /trading-hall/api/v1/search/ [post]
+
+// GetTradingHall godoc
+// @Summary      Get trading hall by id
+// @Tags         trading hall
+// @Produce      json
+// @Param        id      path   int     true   "TradingHall id"
+// @Success      200  {object}  TradingHallResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /trading-hall/api/v1/{id}/ [get]
+
+// CreateTradingHall godoc
+// @Summary      Create trading hall
+// @Tags         trading hall
+// @Accept       json
+// @Produce      json
+// @Param        body  body  CreateTradingHallRequest  true  "TradingHall"
+// @Success      201  {object}  TradingHallResponse
+// @Failure      400,401,403,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /trading-hall/api/v1/ [post]
+
+// ReplaceTradingHall godoc
+// @Summary      Replace trading hall
+// @Description  Fields left out of the body are cleared.
+// @Tags         trading hall
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "TradingHall id"
+// @Param        body  body  CreateTradingHallRequest  true  "TradingHall"
+// @Success      200  {object}  TradingHallResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /trading-hall/api/v1/{id}/ [put]
+
+// UpdateTradingHall godoc
+// @Summary      Update trading hall fields
+// @Description  Only the fields present in the body are changed.
+// @Tags         trading hall
+// @Accept       json
+// @Produce      json
+// @Param        id    path  int  true  "TradingHall id"
+// @Param        body  body  CreateTradingHallRequest  true  "Fields to change"
+// @Success      200  {object}  TradingHallResponse
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /trading-hall/api/v1/{id}/ [patch]
+
+// DeleteTradingHall godoc
+// @Summary      Delete trading hall
+// @Tags         trading hall
+// @Param        id  path  int  true  "TradingHall id"
+// @Success      204
+// @Failure      400,401,403,404,429  {object}  apperror.Problem
+// @Security     BearerAuth
+// @Security     APIKeyAuth
+// @Router       /trading-hall/api/v1/{id}/ [delete]
@@ -1,38 +1,58 @@
 package main
 
 import (
+	"context"
+	"ibrokers_service/internal/api_key"
 	"ibrokers_service/internal/broker"
 	"ibrokers_service/internal/buy_method"
-
-	// "ibrokers_service/internal/commodity"
-	// "ibrokers_service/internal/contract_type"
-	// "ibrokers_service/internal/currency_unit"
-	// "ibrokers_service/internal/delivery_place"
-	// "ibrokers_service/internal/group"
-	// "ibrokers_service/internal/group_hall"
-	// "ibrokers_service/internal/hall_menu_group"
-	// "ibrokers_service/internal/hall_menu_sub_group"
-	// "ibrokers_service/internal/main_group"
-	// "ibrokers_service/internal/manufacturers"
-	// "ibrokers_service/internal/measure_unit"
-
-	// "ibrokers_service/internal/offer_mod"
-	// "ibrokers_service/internal/offer_type"
-	// "ibrokers_service/internal/packaging_type"
-	// "ibrokers_service/internal/report"
-	// "ibrokers_service/internal/settlement"
-	// "ibrokers_service/internal/sub_group"
-	// "ibrokers_service/internal/supplier"
-	// "ibrokers_service/internal/trading_hall"
+	"ibrokers_service/internal/commodity"
+	"ibrokers_service/internal/contract_type"
+	"ibrokers_service/internal/currency_unit"
+	"ibrokers_service/internal/delivery_place"
+	"ibrokers_service/internal/group"
+	"ibrokers_service/internal/group_hall"
+	"ibrokers_service/internal/hall_menu_group"
+	"ibrokers_service/internal/hall_menu_sub_group"
+	"ibrokers_service/internal/main_group"
+	"ibrokers_service/internal/manufacturers"
+	"ibrokers_service/internal/measure_unit"
+	"ibrokers_service/internal/offer"
+	"ibrokers_service/internal/offer_mod"
+	"ibrokers_service/internal/offer_type"
+	"ibrokers_service/internal/packaging_type"
+	"ibrokers_service/internal/report"
+	"ibrokers_service/internal/settlement"
+	"ibrokers_service/internal/sub_group"
+	"ibrokers_service/internal/supplier"
+	"ibrokers_service/internal/trading_hall"
+	"ibrokers_service/migrations"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/auth"
 	"ibrokers_service/pkg/configs"
+	"ibrokers_service/pkg/crud"
+	"ibrokers_service/pkg/health"
+	"ibrokers_service/pkg/lifecycle"
+	"ibrokers_service/pkg/logging"
+	"ibrokers_service/pkg/metrics"
 	"ibrokers_service/pkg/middleware/error_handler"
 	"ibrokers_service/pkg/middleware/filter"
-	"ibrokers_service/pkg/middleware/filter/operators"
 	"ibrokers_service/pkg/middleware/logger"
+	"ibrokers_service/pkg/middleware/naming"
+	"ibrokers_service/pkg/middleware/ordering"
 	"ibrokers_service/pkg/middleware/pagination"
+	"ibrokers_service/pkg/middleware/timeout"
+	"ibrokers_service/pkg/migrate"
+	"ibrokers_service/pkg/ratelimit"
+	"ibrokers_service/pkg/requestid"
+	"ibrokers_service/pkg/tracing"
 	"ibrokers_service/pkg/utils/manager"
 	"log"
+	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
 	"time"
 
 	docs "ibrokers_service/docs"
@@ -41,57 +61,196 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/grafana/loki-client-go/loki"
 	"github.com/grafana/loki-client-go/pkg/urlutil"
+	"github.com/redis/go-redis/v9"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
 )
 
+// @title                       ibrokers service
+// @version                     1.0
+// @description                 Reference data and offers of the exchange brokers.
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 Bearer followed by a JWT.
+// @securityDefinitions.apikey  APIKeyAuth
+// @in                          header
+// @name                        X-API-Key
 func main() {
 	var err error
 
-	// Loki client
-	lokiClient := setupLokiClient()
+	// Config
+	cfg := loadConfig()
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(cfg, os.Args[2:])
+		return
+	}
+	if err = cfg.Validate(); err != nil {
+		log.Fatalf("config: %v", err)
+	}
+
+	// stopped in reverse: the server, the database, tracing, the logs
+	var lc lifecycle.Lifecycle
+
+	// Logging
+	logSink, logBuffers := setupLogging(&lc, cfg.Log, cfg.Loki)
+	metrics.RegisterLogSinks(logBuffers)
+
+	// Tracing
+	setupTracing(&lc, cfg)
 
 	// Minio
-	fileManager := setupMinio()
+	fileManager := setupMinio(cfg.Minio, cfg.Media)
 
 	// GORM
-	db := setupDatabase()
+	db := setupDatabase(&lc, cfg.Database)
 
 	// Migrations
-	if err = db.AutoMigrate(&broker.Broker{}); err != nil {
-		log.Fatalf("Migrations failed: %v", err)
-	}
+	migrator := checkMigrations(db)
 
-	app := gin.Default()
+	app := gin.New()
+	// gin trusts X-Forwarded-For from anyone by default, which would let
+	// clients choose the IP their rate limit bucket is keyed by
+	if err = app.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatalf("trusted proxies: %v", err)
+	}
 	docs.SwaggerInfo.BasePath = "/"
 
 	// Middleware
-	setupMiddleware(app, lokiClient)
+	setupMiddleware(app, cfg, logSink)
+
+	// Auth
+	access := setupAuth(&lc, app, cfg.Auth)
+	setupAPIKeys(app, db)
+
+	// Rate limits, after auth to tell clients apart
+	setupRateLimit(&lc, app, cfg.RateLimit)
 
 	// Routing
 	router := app.RouterGroup
-	setupRoutes(&router, db, fileManager)
+	setupRoutes(&router, db, fileManager, access)
+	app.NoRoute(func(c *gin.Context) {
+		apperror.Write(c, apperror.ErrNotFound.WithDetail("no route for %s %s", c.Request.Method, c.Request.URL.Path))
+	})
+
+	// Metrics
+	router.GET("/metrics", metrics.Handler())
+
+	// Health
+	setupHealth(&router, cfg, db, migrator, fileManager)
 
 	// Swagger
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	err = app.Run(":5500")
+	// Server
+	serve(&lc, cfg.Server, app)
+}
+
+// loadConfig reads CONFIG_FILE, or config/config.yaml when it exists, and
+// the environment.
+func loadConfig() configs.Config {
+	path := os.Getenv("CONFIG_FILE")
+	if path == "" {
+		if _, err := os.Stat(configs.DefaultFile); err == nil {
+			path = configs.DefaultFile
+		}
+	}
+	cfg, err := configs.Load(path)
 	if err != nil {
-		log.Fatalln(err)
+		log.Fatalf("config: %v", err)
+	}
+	return cfg
+}
+
+func runMigrate(cfg configs.Config, args []string) {
+	open := func() (*gorm.DB, error) {
+		if err := cfg.ValidateDatabase(); err != nil {
+			return nil, err
+		}
+		return gorm.Open(postgres.Open(cfg.Database.DSN()), &gorm.Config{})
+	}
+	if err := migrate.Run(args, migrations.FS, "migrations", open, os.Stdout); err != nil {
+		log.Fatalf("migrate: %v", err)
 	}
 }
 
-func setupLokiClient() *loki.Client {
-	lokiURL, err := url.Parse("http://localhost:3100/loki/api/v1/push")
+// checkMigrations refuses to serve on a schema that is behind the code. The
+// migrator is kept for the readiness check.
+func checkMigrations(db *gorm.DB) *migrate.Migrator {
+	migrator, err := migrate.New(db, migrations.FS)
+	if err != nil {
+		log.Fatalf("migrations: %v", err)
+	}
+	pending, err := migrator.Pending()
+	if err != nil {
+		log.Fatalf("migrations: %v", err)
+	}
+	if len(pending) > 0 {
+		log.Fatalf("database schema is behind: %d pending migrations, starting with %s; run `migrate up`", len(pending), pending[0])
+	}
+	return migrator
+}
+
+// setupLogging opens the configured sinks, each behind its own buffer so a
+// slow Loki does not hold up stdout. The buffers are returned for their
+// metrics and flushed on shutdown.
+func setupLogging(lc *lifecycle.Lifecycle, cfg configs.LogConfig, lokiConfig configs.LokiConfig) (logging.Sink, []*logging.Buffered) {
+	var buffers []*logging.Buffered
+	add := func(name string, sink logging.Sink) {
+		buffer := logging.NewBuffered(name, sink, cfg.BufferSize, cfg.BlockTimeout)
+		lc.OnStop("log sink "+name, func(context.Context) error { return buffer.Close() })
+		buffers = append(buffers, buffer)
+	}
+	for _, name := range cfg.Sinks {
+		switch name {
+		case configs.SinkStdout:
+			add(name, logging.NewStdout())
+		case configs.SinkFile:
+			file, err := logging.NewFile(cfg.File)
+			if err != nil {
+				log.Fatal(err)
+			}
+			add(name, file)
+		case configs.SinkLoki:
+			add(name, logging.Loki{Client: setupLokiClient(lokiConfig)})
+		}
+	}
+	sinks := make([]logging.Sink, len(buffers))
+	for i, buffer := range buffers {
+		sinks[i] = buffer
+	}
+	return logging.Multi(sinks...), buffers
+}
+
+// setupTracing installs the tracer provider, which exports the spans not
+// yet sent on shutdown.
+func setupTracing(lc *lifecycle.Lifecycle, cfg configs.Config) {
+	shutdown, err := tracing.Setup(context.Background(), tracing.Options{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		ServiceName: cfg.Tracing.ServiceName,
+		Environment: cfg.Env,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		log.Fatalf("tracing: %v", err)
+	}
+	lc.OnStop("tracing", shutdown)
+}
+
+func setupLokiClient(cfg configs.LokiConfig) *loki.Client {
+	lokiURL, err := url.Parse(cfg.URL)
 	if err != nil {
 		log.Fatalf("error parsing Loki URL: %v", err)
 	}
 
 	lokiClient, err := loki.New(loki.Config{
 		URL:     urlutil.URLValue{URL: lokiURL},
-		Timeout: 10 * time.Second,
+		Timeout: cfg.Timeout,
 	})
 
 	if err != nil {
@@ -100,147 +259,350 @@ func setupLokiClient() *loki.Client {
 	return lokiClient
 }
 
-func setupMinio() *manager.FileManager {
-	_minio := configs.NewMinio(false, configs.MINIO_SECRET_KEY, configs.MINIO_ACCESS_KEY, configs.MINIO_URL)
-	return manager.NewFileManager(configs.BASE_URL, *_minio)
+func setupMinio(cfg configs.MinioConfig, media configs.MediaConfig) *manager.FileManager {
+	_minio, err := configs.NewMinio(cfg.UseSSL, cfg.SecretKey, cfg.AccessKey, cfg.Endpoint)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return manager.NewFileManager(media.BaseDir, media.Root, *_minio)
 }
 
-func setupDatabase() *gorm.DB {
-	dsn := "host=localhost user=postgres password=postgres dbname=postgres port=5432 sslmode=disable TimeZone=Asia/Tehran"
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+func setupDatabase(lc *lifecycle.Lifecycle, cfg configs.DatabaseConfig) *gorm.DB {
+	// TranslateError reports unique and foreign key violations as gorm
+	// errors, which apperror answers with 409
+	db, err := gorm.Open(postgres.Open(cfg.DSN()), &gorm.Config{
+		TranslateError: true,
+		Logger: requestid.GormLogger(log.New(os.Stdout, "\r\n", log.LstdFlags), gormlogger.Config{
+			SlowThreshold: 200 * time.Millisecond,
+			LogLevel:      gormlogger.Warn,
+		}),
+	})
 	if err != nil {
 		log.Fatalf("db not connected: %v", err)
 	}
+	if err := db.Use(metrics.GormPlugin{}); err != nil {
+		log.Fatalf("db metrics: %v", err)
+	}
+	if err := db.Use(tracing.GormPlugin{}); err != nil {
+		log.Fatalf("db tracing: %v", err)
+	}
+	lc.OnStop("database", func(context.Context) error {
+		pool, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return pool.Close()
+	})
 	return db
 }
 
-func setupMiddleware(app *gin.Engine, lokiClient *loki.Client) {
+// serve runs the HTTP server until SIGINT or SIGTERM, then stops everything
+// registered in lc within the shutdown timeout, starting with the server:
+// it stops accepting connections and waits for the requests in flight.
+func serve(lc *lifecycle.Lifecycle, cfg configs.ServerConfig, handler http.Handler) {
+	server := &http.Server{
+		Addr:              cfg.Addr(),
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+	lc.OnStop("http server", server.Shutdown)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	failed := make(chan error, 1)
+	go func() {
+		failed <- server.ListenAndServe()
+	}()
+
+	exitCode := 0
+	select {
+	case err := <-failed:
+		log.Printf("server: %v", err)
+		exitCode = 1
+	case <-ctx.Done():
+		log.Printf("shutdown: signal received, draining for up to %v", cfg.ShutdownTimeout)
+	}
+	// a second signal kills the process at once
+	stop()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := lc.Stop(shutdownCtx); err != nil {
+		exitCode = 1
+	}
+	if exitCode != 0 {
+		cancel()
+		os.Exit(exitCode)
+	}
+}
+
+// setupHealth serves /healthz for liveness, /readyz for readiness and
+// /status with the details of every dependency.
+func setupHealth(router *gin.RouterGroup, cfg configs.Config, db *gorm.DB, migrator *migrate.Migrator, fileManager *manager.FileManager) {
+	checker := health.Checker{
+		Checks: []health.Check{
+			health.Database(db),
+			health.Migrations(migrator),
+			health.Minio(fileManager.MinioClient.Client, cfg.Minio.HealthBucket),
+		},
+		Timeout: cfg.Server.HealthTimeout,
+	}
+	if cfg.Log.Enabled(configs.SinkLoki) {
+		checker.Checks = append(checker.Checks, health.Loki(cfg.Loki.URL))
+	}
+	router.GET("/healthz", health.Live())
+	router.GET("/readyz", health.Ready(checker))
+	router.GET("/status", health.Status(checker, health.NewInfo(cfg.Tracing.ServiceName, cfg.Env)))
+}
+
+func setupMiddleware(app *gin.Engine, cfg configs.Config, logSink logging.Sink) {
+	// outside the recovery, so requests that panicked are counted as the 500
+	// it answers
+	app.Use(metrics.Middleware())
+	// outside the recovery too, so the span of a request that panicked ends
+	// with the 500 and the recovery can name its trace in the incident
+	app.Use(tracing.Middleware())
+	// before the rest, so it also catches panics of the middleware below
+	app.Use(error_handler.Recovery(logSink))
+	app.Use(requestid.Middleware())
+	// right after the request ID, so requests the middleware below rejects,
+	// like preflights, timeouts and bad filters, are logged too
+	app.Use(logger.Logger(logSink))
 	app.Use(cors.New(cors.Config{
-		AllowOrigins:     configs.CORS_URL,
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE"},
-		AllowHeaders:     []string{"Origin"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowOrigins:     cfg.CORS.AllowOrigins,
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", api_key.Header, naming.Header, requestid.Header, "traceparent", "tracestate"},
+		ExposeHeaders:    []string{"Content-Length", requestid.Header, ratelimit.HeaderLimit, ratelimit.HeaderRemaining, ratelimit.HeaderReset, ratelimit.HeaderPolicy, "Retry-After"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 
-	app.Use(pagination.Middleware())
-	gormOperator := operators.GormOperator{}
-	filterMapper := filter.Mapper{Operators: gormOperator}
+	app.Use(timeout.Middleware(cfg.Server.RequestTimeout, cfg.Server.RouteTimeouts))
+	app.Use(pagination.Middleware(cfg.API.MaxPageSize))
+	app.Use(ordering.Middleware())
+	app.Use(naming.Middleware(cfg.API.LegacyFieldNames))
+	filterMapper := filter.Mapper{}
 	app.Use(filter.QueryFilterMiddleware(filterMapper))
-	app.Use(logger.Logger(lokiClient))
-	app.Use(error_handler.ErrorHandlingMiddleware())
 }
 
-func setupRoutes(router *gin.RouterGroup, db *gorm.DB, fileManager *manager.FileManager) {
+// routeAccess holds the guards of the resource routes: reference data
+// needs reference:write to change, offers need offer:publish and API keys
+// admin. KeyRoutes is false when nobody could be told to be an admin, so
+// the API key routes are not mounted at all.
+type routeAccess struct {
+	Reference crud.Access
+	Offer     crud.Access
+	Admin     crud.Access
+	KeyRoutes bool
+}
+
+// setupAuth authenticates the bearer token of every request and returns
+// the guards of the routes, all open when auth is disabled. JWKS URLs are
+// refreshed in the background until shutdown.
+func setupAuth(lc *lifecycle.Lifecycle, app *gin.Engine, cfg configs.AuthConfig) routeAccess {
+	if !cfg.Enabled {
+		log.Print("auth: disabled, every route is open and the api key routes are not mounted")
+		return routeAccess{}
+	}
+	verifier := &auth.Verifier{
+		Algorithms: cfg.Algorithms,
+		Secret:     []byte(cfg.HS256Secret),
+		Issuer:     cfg.Issuer,
+		Audience:   cfg.Audience,
+		Leeway:     cfg.Leeway,
+		Roles:      cfg.Roles,
+	}
+	if slices.Contains(cfg.Algorithms, configs.AlgorithmRS256) {
+		keys := &auth.KeySet{URL: cfg.JWKSURL, File: cfg.JWKSFile, Refresh: cfg.JWKSRefresh}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := keys.Load(ctx); err != nil {
+			log.Fatalf("auth: %v", err)
+		}
+		lc.Go("jwks refresh", keys.Run)
+		verifier.Keys = keys
+	}
+	app.Use(auth.Authenticate(verifier))
+
+	admin := auth.Require(auth.Admin)
+	access := routeAccess{
+		Reference: crud.Access{Write: auth.Require(auth.PermReferenceWrite)},
+		Offer:     crud.Access{Write: auth.Require(auth.PermOfferPublish)},
+		Admin:     crud.Access{Read: admin, Write: admin},
+		KeyRoutes: true,
+	}
+	if cfg.ProtectReads {
+		access.Reference.Read = auth.Require(auth.PermReferenceRead)
+		access.Offer.Read = auth.Require(auth.PermReferenceRead)
+	}
+	return access
+}
+
+// setupAPIKeys authenticates the X-API-Key header of every request,
+// whether bearer tokens are checked or not.
+func setupAPIKeys(app *gin.Engine, db *gorm.DB) {
+	app.Use(api_key.Authenticate(api_key.Service{Repository: api_key.NewRepository(db)}))
+}
+
+// setupRateLimit limits every client per route policy, in memory or in
+// Redis.
+func setupRateLimit(lc *lifecycle.Lifecycle, app *gin.Engine, cfg configs.RateLimitConfig) {
+	if !cfg.Enabled {
+		return
+	}
+	limiter := ratelimit.Limiter{
+		Default:  ratelimit.Policy(cfg.Default),
+		Routes:   make(map[string]ratelimit.Policy, len(cfg.Routes)),
+		FailOpen: cfg.FailOpen,
+	}
+	for route, policy := range cfg.Routes {
+		limiter.Routes[route] = ratelimit.Policy(policy)
+	}
+	switch cfg.Backend {
+	case configs.BackendRedis:
+		store := &ratelimit.Redis{
+			Client: redis.NewClient(&redis.Options{
+				Addr:         cfg.Redis.Addr,
+				Password:     cfg.Redis.Password,
+				DB:           cfg.Redis.DB,
+				DialTimeout:  cfg.Redis.Timeout,
+				ReadTimeout:  cfg.Redis.Timeout,
+				WriteTimeout: cfg.Redis.Timeout,
+			}),
+			Prefix: "ibrokers:ratelimit:",
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := store.Ping(ctx); err != nil {
+			log.Printf("rate limit: %v", err)
+		}
+		lc.OnStop("rate limit store", func(context.Context) error { return store.Close() })
+		limiter.Store = store
+	default:
+		store := ratelimit.NewMemory()
+		lc.Go("rate limit sweep", store.Run)
+		limiter.Store = store
+	}
+	app.Use(limiter.Middleware())
+}
+
+func setupRoutes(router *gin.RouterGroup, db *gorm.DB, fileManager *manager.FileManager, access routeAccess) {
+	if access.KeyRoutes {
+		srv := api_key.Service{Repository: api_key.NewRepository(db)}
+		api_key.CreateEndpoint(srv, router.Group("/api-key")).WithAccess(access.Admin).V1()
+	}
 	{
 		rep := broker.Repository{DB: db}
 		srv := broker.Service{Repository: rep}
-		broker.CreateEndpoint(srv, router.Group("/broker"), *fileManager).V1()
+		broker.CreateEndpoint(srv, router.Group("/broker"), *fileManager).WithAccess(access.Reference).V1()
 	}
 	{
 		rep := buy_method.Repository{DB: db}
 		srv := buy_method.Service{Repository: rep}
-		buy_method.CreateEndpoint(srv, router.Group("/buy-method"), *fileManager).V1()
-	}
-	// {
-	// 	rep := commodity.Repository{DB: db}
-	// 	srv := commodity.Service{Repository: rep}
-	// 	commodity.CreateEndpoint(srv, router.Group("/commodity"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := contract_type.Repository{DB: db}
-	// 	srv := contract_type.Service{Repository: rep}
-	// 	contract_type.CreateEndpoint(srv, router.Group("/contract-type"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := currency_unit.Repository{DB: db}
-	// 	srv := currency_unit.Service{Repository: rep}
-	// 	currency_unit.CreateEndpoint(srv, router.Group("/contract-unit"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := delivery_place.Repository{DB: db}
-	// 	srv := delivery_place.Service{Repository: rep}
-	// 	delivery_place.CreateEndpoint(srv, router.Group("/delivery-place"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := main_group.Repository{DB: db}
-	// 	srv := main_group.Service{Repository: rep}
-	// 	main_group.CreateEndpoint(srv, router.Group("/menu-group"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := group.Repository{DB: db}
-	// 	srv := group.Service{Repository: rep}
-	// 	group.CreateEndpoint(srv, router.Group("/group"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := sub_group.Repository{DB: db}
-	// 	srv := sub_group.Service{Repository: rep}
-	// 	sub_group.CreateEndpoint(srv, router.Group("/sub-group"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := group_hall.Repository{DB: db}
-	// 	srv := group_hall.Service{Repository: rep}
-	// 	group_hall.CreateEndpoint(srv, router.Group("/group-hall"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := hall_menu_group.Repository{DB: db}
-	// 	srv := hall_menu_group.Service{Repository: rep}
-	// 	hall_menu_group.CreateEndpoint(srv, router.Group("/hall-menu-group"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := trading_hall.Repository{DB: db}
-	// 	srv := trading_hall.Service{Repository: rep}
-	// 	trading_hall.CreateEndpoint(srv, router.Group("/trading-hall"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := hall_menu_sub_group.Repository{DB: db}
-	// 	srv := hall_menu_sub_group.Service{Repository: rep}
-	// 	hall_menu_sub_group.CreateEndpoint(srv, router.Group("/hall-menu-sub-group"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := manufacturers.Repository{DB: db}
-	// 	srv := manufacturers.Service{Repository: rep}
-	// 	manufacturers.CreateEndpoint(srv, router.Group("/manufacturers"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := measure_unit.Repository{DB: db}
-	// 	srv := measure_unit.Service{Repository: rep}
-	// 	measure_unit.CreateEndpoint(srv, router.Group("/measure-unit"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := offer.Repository{DB: db}
-	// 	srv := offer.Service{Repository: rep}
-	// 	offer.CreateEndpoint(srv, router.Group("/offer"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := offer_mod.Repository{DB: db}
-	// 	srv := offer_mod.Service{Repository: rep}
-	// 	offer_mod.CreateEndpoint(srv, router.Group("/offer-mod"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := offer_type.Repository{DB: db}
-	// 	srv := offer_type.Service{Repository: rep}
-	// 	offer_type.CreateEndpoint(srv, router.Group("/offer-type"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := packaging_type.Repository{DB: db}
-	// 	srv := packaging_type.Service{Repository: rep}
-	// 	packaging_type.CreateEndpoint(srv, router.Group("/packaging-type"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := report.Repository{DB: db}
-	// 	srv := report.Service{Repository: rep}
-	// 	report.CreateEndpoint(srv, router.Group("/report"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := settlement.Repository{DB: db}
-	// 	srv := settlement.Service{Repository: rep}
-	// 	settlement.CreateEndpoint(srv, router.Group("/settlement"), *fileManager).V1()
-	// }
-	// {
-	// 	rep := supplier.Repository{DB: db}
-	// 	srv := supplier.Service{Repository: rep}
-	// 	supplier.CreateEndpoint(srv, router.Group("/supplier"), *fileManager).V1()
-	// }
+		buy_method.CreateEndpoint(srv, router.Group("/buy-method"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := commodity.Repository{DB: db}
+		srv := commodity.NewService(rep)
+		commodity.CreateEndpoint(srv, router.Group("/commodity"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := contract_type.Repository{DB: db}
+		srv := contract_type.Service{Repository: rep}
+		contract_type.CreateEndpoint(srv, router.Group("/contract-type"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := currency_unit.Repository{DB: db}
+		srv := currency_unit.Service{Repository: rep}
+		currency_unit.CreateEndpoint(srv, router.Group("/currency-unit"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := delivery_place.Repository{DB: db}
+		srv := delivery_place.Service{Repository: rep}
+		delivery_place.CreateEndpoint(srv, router.Group("/delivery-place"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := main_group.Repository{DB: db}
+		srv := main_group.Service{Repository: rep}
+		main_group.CreateEndpoint(srv, router.Group("/main-group"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := group.Repository{DB: db}
+		srv := group.NewService(rep)
+		group.CreateEndpoint(srv, router.Group("/group"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := sub_group.Repository{DB: db}
+		srv := sub_group.NewService(rep)
+		sub_group.CreateEndpoint(srv, router.Group("/sub-group"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := group_hall.Repository{DB: db}
+		srv := group_hall.NewService(rep)
+		group_hall.CreateEndpoint(srv, router.Group("/group-hall"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := hall_menu_group.Repository{DB: db}
+		srv := hall_menu_group.NewService(rep)
+		hall_menu_group.CreateEndpoint(srv, router.Group("/hall-menu-group"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := trading_hall.Repository{DB: db}
+		srv := trading_hall.Service{Repository: rep}
+		trading_hall.CreateEndpoint(srv, router.Group("/trading-hall"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := hall_menu_sub_group.Repository{DB: db}
+		srv := hall_menu_sub_group.NewService(rep)
+		hall_menu_sub_group.CreateEndpoint(srv, router.Group("/hall-menu-sub-group"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := manufacturers.Repository{DB: db}
+		srv := manufacturers.Service{Repository: rep}
+		manufacturers.CreateEndpoint(srv, router.Group("/manufacturers"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := measure_unit.Repository{DB: db}
+		srv := measure_unit.Service{Repository: rep}
+		measure_unit.CreateEndpoint(srv, router.Group("/measure-unit"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := offer.Repository{DB: db}
+		srv := offer.NewService(rep)
+		offer.CreateEndpoint(srv, router.Group("/offer"), *fileManager).WithAccess(access.Offer).V1()
+	}
+	{
+		rep := offer_mod.Repository{DB: db}
+		srv := offer_mod.Service{Repository: rep}
+		offer_mod.CreateEndpoint(srv, router.Group("/offer-mod"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := offer_type.Repository{DB: db}
+		srv := offer_type.Service{Repository: rep}
+		offer_type.CreateEndpoint(srv, router.Group("/offer-type"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := packaging_type.Repository{DB: db}
+		srv := packaging_type.Service{Repository: rep}
+		packaging_type.CreateEndpoint(srv, router.Group("/packaging-type"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := report.Repository{DB: db}
+		srv := report.Service{Repository: rep}
+		report.CreateEndpoint(srv, router.Group("/report"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := settlement.Repository{DB: db}
+		srv := settlement.Service{Repository: rep}
+		settlement.CreateEndpoint(srv, router.Group("/settlement"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	{
+		rep := supplier.Repository{DB: db}
+		srv := supplier.Service{Repository: rep}
+		supplier.CreateEndpoint(srv, router.Group("/supplier"), *fileManager).WithAccess(access.Reference).V1()
+	}
+	// scaffold:routes
 }
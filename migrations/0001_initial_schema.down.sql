@@ -0,0 +1,22 @@
+DROP TABLE IF EXISTS offers;
+DROP TABLE IF EXISTS suppliers;
+DROP TABLE IF EXISTS settlements;
+DROP TABLE IF EXISTS reports;
+DROP TABLE IF EXISTS packaging_types;
+DROP TABLE IF EXISTS offer_types;
+DROP TABLE IF EXISTS offer_mods;
+DROP TABLE IF EXISTS measure_units;
+DROP TABLE IF EXISTS manufacturers;
+DROP TABLE IF EXISTS hall_menu_sub_groups;
+DROP TABLE IF EXISTS hall_menu_groups;
+DROP TABLE IF EXISTS group_halls;
+DROP TABLE IF EXISTS trading_halls;
+DROP TABLE IF EXISTS sub_groups;
+DROP TABLE IF EXISTS groups;
+DROP TABLE IF EXISTS main_groups;
+DROP TABLE IF EXISTS delivery_places;
+DROP TABLE IF EXISTS currency_units;
+DROP TABLE IF EXISTS contract_types;
+DROP TABLE IF EXISTS commodities;
+DROP TABLE IF EXISTS buy_methods;
+DROP TABLE IF EXISTS brokers;
@@ -0,0 +1,207 @@
+-- Tables as they were created by gorm's AutoMigrate, plus the NOT NULLs and
+-- foreign keys it left out. A database that AutoMigrate set up fails here on
+-- the first existing table rather than adopting it unchecked: bring its
+-- columns, nullability, indexes and constraints in line with this file, then
+-- record the migration without running it with "migrate baseline 1".
+
+CREATE TABLE brokers (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text,
+    spotid bigint,
+    derivativesid bigint,
+    nationalid text
+);
+
+CREATE TABLE buy_methods (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE commodities (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text,
+    subgroupid bigint
+);
+CREATE INDEX idx_commodities_subgroupid ON commodities (subgroupid);
+
+CREATE TABLE contract_types (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE currency_units (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE delivery_places (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE main_groups (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE groups (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text,
+    maingroupid bigint
+);
+CREATE INDEX idx_groups_maingroupid ON groups (maingroupid);
+
+CREATE TABLE sub_groups (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text,
+    groupid bigint
+);
+CREATE INDEX idx_sub_groups_groupid ON sub_groups (groupid);
+
+CREATE TABLE trading_halls (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE group_halls (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text,
+    groupid bigint,
+    tradinghallid bigint
+);
+CREATE INDEX idx_group_halls_groupid ON group_halls (groupid);
+CREATE INDEX idx_group_halls_tradinghallid ON group_halls (tradinghallid);
+
+CREATE TABLE hall_menu_groups (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text,
+    tradinghallid bigint
+);
+CREATE INDEX idx_hall_menu_groups_tradinghallid ON hall_menu_groups (tradinghallid);
+
+CREATE TABLE hall_menu_sub_groups (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text,
+    hallmenugroupid bigint
+);
+CREATE INDEX idx_hall_menu_sub_groups_hallmenugroupid ON hall_menu_sub_groups (hallmenugroupid);
+
+CREATE TABLE manufacturers (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE measure_units (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE offer_mods (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE offer_types (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE packaging_types (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE reports (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE settlements (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text
+);
+
+CREATE TABLE suppliers (
+    id bigserial PRIMARY KEY,
+    description text,
+    persianname text,
+    nationalid text
+);
+
+CREATE TABLE offers (
+    buymethodid bigint,
+    brokerid bigint,
+    commodityid bigint,
+    contracttypeid bigint,
+    currencyid bigint,
+    deliveryplaceid bigint,
+    initprice bigint,
+    initvolume text,
+    lotsize bigint,
+    manufacturerid bigint,
+    maxinitprice bigint,
+    maxincoffervol bigint,
+    maxordervol bigint,
+    maxofferprice bigint,
+    measureunitid bigint,
+    minallocationvol bigint,
+    minoffervol bigint,
+    mininitprice bigint,
+    minordervol bigint,
+    minofferprice bigint,
+    offermodeid bigint,
+    offertypeid bigint,
+    offervol bigint,
+    packagingtypeid bigint,
+    permissibleerror bigint,
+    pricediscoveryminordervol bigint,
+    prepaymentpercent bigint,
+    securitytypeid bigint,
+    settlementtypeid bigint,
+    supplierid bigint,
+    ticksize bigint,
+    tradinghallid bigint,
+    weightfactor bigint,
+    id bigserial PRIMARY KEY,
+    deliverydate date,
+    description text,
+    offerdate date,
+    offerring text,
+    offersymbol text,
+    securitytypenote text,
+    tradestatus text,
+    CONSTRAINT fk_offers_broker FOREIGN KEY (brokerid) REFERENCES brokers (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_buy_method FOREIGN KEY (buymethodid) REFERENCES buy_methods (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_commodity FOREIGN KEY (commodityid) REFERENCES commodities (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_contract_type FOREIGN KEY (contracttypeid) REFERENCES contract_types (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_currency FOREIGN KEY (currencyid) REFERENCES currency_units (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_delivery_place FOREIGN KEY (deliveryplaceid) REFERENCES delivery_places (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_manufacturer FOREIGN KEY (manufacturerid) REFERENCES manufacturers (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_measure_unit FOREIGN KEY (measureunitid) REFERENCES measure_units (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_offer_mode FOREIGN KEY (offermodeid) REFERENCES offer_mods (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_offer_type FOREIGN KEY (offertypeid) REFERENCES offer_types (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_packaging_type FOREIGN KEY (packagingtypeid) REFERENCES packaging_types (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_settlement_type FOREIGN KEY (settlementtypeid) REFERENCES settlements (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_supplier FOREIGN KEY (supplierid) REFERENCES suppliers (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_offers_trading_hall FOREIGN KEY (tradinghallid) REFERENCES trading_halls (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
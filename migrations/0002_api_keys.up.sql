@@ -0,0 +1,18 @@
+-- API keys of partner brokers. Only the SHA-256 of a key is stored, the
+-- prefix is its public part and finds the row.
+
+CREATE TABLE api_keys (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL,
+    prefix text NOT NULL,
+    secret_hash text NOT NULL,
+    scopes text NOT NULL DEFAULT '',
+    broker_id bigint NOT NULL,
+    expires_at timestamptz,
+    last_used_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    CONSTRAINT fk_api_keys_broker FOREIGN KEY (broker_id) REFERENCES brokers (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE UNIQUE INDEX idx_api_keys_prefix ON api_keys (prefix);
+CREATE INDEX idx_api_keys_broker_id ON api_keys (broker_id);
@@ -0,0 +1,7 @@
+ALTER TABLE hall_menu_sub_groups DROP CONSTRAINT IF EXISTS fk_hall_menu_sub_groups_hall_menu_group;
+ALTER TABLE hall_menu_groups DROP CONSTRAINT IF EXISTS fk_hall_menu_groups_trading_hall;
+ALTER TABLE group_halls DROP CONSTRAINT IF EXISTS fk_group_halls_trading_hall;
+ALTER TABLE group_halls DROP CONSTRAINT IF EXISTS fk_group_halls_group;
+ALTER TABLE sub_groups DROP CONSTRAINT IF EXISTS fk_sub_groups_group;
+ALTER TABLE groups DROP CONSTRAINT IF EXISTS fk_groups_main_group;
+ALTER TABLE commodities DROP CONSTRAINT IF EXISTS fk_commodities_sub_group;
@@ -0,0 +1,24 @@
+-- Foreign keys between the reference tables. Rows written before these
+-- columns were nullable hold 0 for "no parent", so those become NULL first.
+
+UPDATE commodities SET subgroupid = NULL WHERE subgroupid = 0;
+UPDATE groups SET maingroupid = NULL WHERE maingroupid = 0;
+UPDATE sub_groups SET groupid = NULL WHERE groupid = 0;
+UPDATE group_halls SET groupid = NULL WHERE groupid = 0;
+UPDATE group_halls SET tradinghallid = NULL WHERE tradinghallid = 0;
+UPDATE hall_menu_groups SET tradinghallid = NULL WHERE tradinghallid = 0;
+UPDATE hall_menu_sub_groups SET hallmenugroupid = NULL WHERE hallmenugroupid = 0;
+
+ALTER TABLE commodities
+    ADD CONSTRAINT fk_commodities_sub_group FOREIGN KEY (subgroupid) REFERENCES sub_groups (id) ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE groups
+    ADD CONSTRAINT fk_groups_main_group FOREIGN KEY (maingroupid) REFERENCES main_groups (id) ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE sub_groups
+    ADD CONSTRAINT fk_sub_groups_group FOREIGN KEY (groupid) REFERENCES groups (id) ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE group_halls
+    ADD CONSTRAINT fk_group_halls_group FOREIGN KEY (groupid) REFERENCES groups (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_group_halls_trading_hall FOREIGN KEY (tradinghallid) REFERENCES trading_halls (id) ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE hall_menu_groups
+    ADD CONSTRAINT fk_hall_menu_groups_trading_hall FOREIGN KEY (tradinghallid) REFERENCES trading_halls (id) ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE hall_menu_sub_groups
+    ADD CONSTRAINT fk_hall_menu_sub_groups_hall_menu_group FOREIGN KEY (hallmenugroupid) REFERENCES hall_menu_groups (id) ON UPDATE CASCADE ON DELETE RESTRICT;
@@ -0,0 +1,9 @@
+// Package migrations holds the versioned SQL migrations of the service. Each
+// version is a pair of files, <version>_<name>.up.sql and .down.sql, and is
+// applied by pkg/migrate.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
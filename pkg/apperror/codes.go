@@ -0,0 +1,85 @@
+package apperror
+
+var (
+	ErrValidation = New(Validation, "validation_failed", Message{
+		En: "The request is not valid.",
+		Fa: "درخواست معتبر نیست.",
+	})
+	ErrInvalidBody = New(Validation, "invalid_body", Message{
+		En: "The request body could not be read.",
+		Fa: "بدنه درخواست قابل خواندن نیست.",
+	})
+	ErrInvalidID = New(Validation, "invalid_id", Message{
+		En: "The id must be an integer.",
+		Fa: "شناسه باید یک عدد صحیح باشد.",
+	})
+	ErrInvalidFilter = New(Validation, "invalid_filter", Message{
+		En: "The filter is not valid.",
+		Fa: "فیلتر معتبر نیست.",
+	})
+	ErrInvalidOrdering = New(Validation, "invalid_ordering", Message{
+		En: "The ordering is not valid.",
+		Fa: "ترتیب مرتب‌سازی معتبر نیست.",
+	})
+	ErrInvalidCursor = New(Validation, "invalid_cursor", Message{
+		En: "The page cursor is not valid.",
+		Fa: "نشانگر صفحه معتبر نیست.",
+	})
+	ErrInvalidExpand = New(Validation, "invalid_expand", Message{
+		En: "The expand parameter is not valid.",
+		Fa: "پارامتر expand معتبر نیست.",
+	})
+	ErrInvalidReference = New(Validation, "invalid_reference", Message{
+		En: "A referenced record does not exist.",
+		Fa: "رکورد ارجاع‌داده‌شده وجود ندارد.",
+	})
+
+	ErrNotFound = New(NotFound, "not_found", Message{
+		En: "The record was not found.",
+		Fa: "رکورد مورد نظر یافت نشد.",
+	})
+
+	ErrDuplicate = New(Conflict, "duplicate", Message{
+		En: "A record with the same unique value already exists.",
+		Fa: "رکوردی با همین مقدار یکتا از قبل وجود دارد.",
+	})
+	ErrStillReferenced = New(Conflict, "still_referenced", Message{
+		En: "The record is still used by other records.",
+		Fa: "این رکورد هنوز در رکوردهای دیگر استفاده شده است.",
+	})
+	ErrAPIKeyRevoked = New(Conflict, "api_key_revoked", Message{
+		En: "The API key is revoked.",
+		Fa: "کلید API باطل شده است.",
+	})
+
+	ErrUnauthorized = New(Unauthorized, "unauthorized", Message{
+		En: "Authentication is required.",
+		Fa: "احراز هویت لازم است.",
+	})
+	ErrInvalidToken = New(Unauthorized, "invalid_token", Message{
+		En: "The access token is not valid.",
+		Fa: "توکن دسترسی معتبر نیست.",
+	})
+	ErrForbidden = New(Forbidden, "forbidden", Message{
+		En: "You do not have permission to do this.",
+		Fa: "شما اجازه انجام این کار را ندارید.",
+	})
+
+	ErrRateLimited = New(TooManyRequests, "rate_limited", Message{
+		En: "Too many requests, slow down and try again later.",
+		Fa: "تعداد درخواست‌ها بیش از حد مجاز است، کمی بعد دوباره تلاش کنید.",
+	})
+
+	ErrUnavailable = New(Unavailable, "unavailable", Message{
+		En: "The service is temporarily unavailable, try again later.",
+		Fa: "سرویس موقتاً در دسترس نیست، بعداً دوباره تلاش کنید.",
+	})
+	ErrTimeout = New(Timeout, "timeout", Message{
+		En: "The request took too long.",
+		Fa: "زمان پاسخ‌گویی به درخواست به پایان رسید.",
+	})
+	ErrInternal = New(Internal, "internal", Message{
+		En: "Internal server error.",
+		Fa: "خطای داخلی سرور.",
+	})
+)
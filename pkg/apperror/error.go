@@ -0,0 +1,143 @@
+// Package apperror holds the errors the service reports to API clients: a
+// kind deciding the HTTP status, a stable machine readable code, messages
+// in English and Persian and, for validation errors, the offending fields.
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type Kind int
+
+const (
+	Internal Kind = iota
+	Validation
+	NotFound
+	Conflict
+	Unavailable
+	Timeout
+	Unauthorized
+	Forbidden
+	TooManyRequests
+)
+
+func (k Kind) Status() int {
+	switch k {
+	case Validation:
+		return http.StatusBadRequest
+	case NotFound:
+		return http.StatusNotFound
+	case Conflict:
+		return http.StatusConflict
+	case Unavailable:
+		return http.StatusServiceUnavailable
+	case Timeout:
+		return http.StatusGatewayTimeout
+	case Unauthorized:
+		return http.StatusUnauthorized
+	case Forbidden:
+		return http.StatusForbidden
+	case TooManyRequests:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Message is a text shown to users, in every language the API answers in.
+type Message struct {
+	En string
+	Fa string
+}
+
+// In returns the message in lang, "fa" or "en"; English is the fallback.
+func (m Message) In(lang string) string {
+	if lang == "fa" && m.Fa != "" {
+		return m.Fa
+	}
+	return m.En
+}
+
+// Field is one rejected request field of a validation error.
+type Field struct {
+	Name    string
+	Code    string
+	Message Message
+}
+
+// Error is a domain error. The variables in codes.go are the templates;
+// handlers and services derive copies with WithDetail, WithFields and Wrap,
+// which still match their template with errors.Is because Is compares codes.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message Message
+	// Detail explains this occurrence to developers, in English.
+	Detail string
+	Fields []Field
+	Err    error
+	// Incident identifies the log entry of an unexpected failure, for
+	// clients to quote when they report it.
+	Incident string
+}
+
+func New(kind Kind, code string, message Message) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	text := e.Code + ": " + e.Message.En
+	if e.Detail != "" {
+		text += " " + e.Detail
+	}
+	if e.Err != nil {
+		text += ": " + e.Err.Error()
+	}
+	return text
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+func (e *Error) WithDetail(format string, args ...any) *Error {
+	c := *e
+	c.Detail = fmt.Sprintf(format, args...)
+	return &c
+}
+
+func (e *Error) WithFields(fields ...Field) *Error {
+	c := *e
+	c.Fields = append(append([]Field(nil), e.Fields...), fields...)
+	return &c
+}
+
+func (e *Error) WithIncident(id string) *Error {
+	c := *e
+	c.Incident = id
+	return &c
+}
+
+// Wrap keeps err as the cause, for logs; clients never see it.
+func (e *Error) Wrap(err error) *Error {
+	c := *e
+	c.Err = err
+	return &c
+}
+
+// KindOf returns the kind of the first Error in err's chain, Internal when
+// there is none.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return Internal
+}
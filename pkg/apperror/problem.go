@@ -0,0 +1,116 @@
+package apperror
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ContentType is the media type of RFC 7807 problem details.
+const ContentType = "application/problem+json"
+
+// StatusClientClosedRequest is logged for requests whose client went away
+// before the response was ready.
+const StatusClientClosedRequest = 499
+
+// Problem is the RFC 7807 body of every error response. Code repeats the
+// last segment of Type so clients can switch on it without parsing URIs.
+type Problem struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Code     string         `json:"code"`
+	Incident string         `json:"incidentId,omitempty"`
+	Errors   []FieldProblem `json:"errors,omitempty"`
+}
+
+type FieldProblem struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// From turns any error into a domain error. Errors of the database driver
+// are classified by what they mean to the client, everything unknown is
+// Internal and keeps the original error as its cause.
+func From(err error) *Error {
+	var e *Error
+	switch {
+	case errors.As(err, &e):
+		return e
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrTimeout.Wrap(err)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrNotFound.Wrap(err)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return ErrDuplicate.Wrap(err)
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return ErrStillReferenced.Wrap(err)
+	case errors.Is(err, driver.ErrBadConn) || isNetError(err):
+		return ErrUnavailable.Wrap(err)
+	default:
+		return ErrInternal.Wrap(err)
+	}
+}
+
+func isNetError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// Write answers the request with the problem for err and aborts the chain.
+// The request context is consulted as well, because drivers do not always
+// wrap the context error of a cancelled query. Causes of internal errors are
+// attached to the gin context for logging and never sent to the client.
+func Write(ctx *gin.Context, err error) {
+	switch reason := ctx.Request.Context().Err(); {
+	case errors.Is(err, context.Canceled) || errors.Is(reason, context.Canceled):
+		// the client is gone, nobody reads a body
+		ctx.AbortWithStatus(StatusClientClosedRequest)
+		return
+	case errors.Is(reason, context.DeadlineExceeded) && KindOf(err) == Internal:
+		err = ErrTimeout.Wrap(err)
+	}
+
+	e := From(err)
+	if e.Err != nil {
+		_ = ctx.Error(e.Err)
+	}
+	lang := Language(ctx)
+	problem := Problem{
+		Type:     "urn:problem:" + e.Code,
+		Title:    e.Message.In(lang),
+		Status:   e.Kind.Status(),
+		Detail:   e.Detail,
+		Instance: ctx.Request.URL.Path,
+		Code:     e.Code,
+		Incident: e.Incident,
+	}
+	for _, f := range e.Fields {
+		problem.Errors = append(problem.Errors, FieldProblem{Field: f.Name, Code: f.Code, Message: f.Message.In(lang)})
+	}
+	ctx.Header("Content-Type", ContentType)
+	ctx.AbortWithStatusJSON(problem.Status, problem)
+}
+
+// Language picks "fa" or "en" from Accept-Language; English is the default.
+func Language(ctx *gin.Context) string {
+	for _, tag := range strings.Split(ctx.GetHeader("Accept-Language"), ",") {
+		tag, _, _ = strings.Cut(strings.TrimSpace(tag), ";")
+		lang, _, _ := strings.Cut(strings.ToLower(tag), "-")
+		switch lang {
+		case "fa":
+			return "fa"
+		case "en":
+			return "en"
+		}
+	}
+	return "en"
+}
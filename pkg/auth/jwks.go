@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// minRefetch limits how often a token with an unknown key ID can make the
+// key set fetch the JWKS again.
+const minRefetch = 30 * time.Second
+
+// KeySet holds the RSA signing keys of a JWKS, read from a URL or a local
+// file. URL key sets are fetched again every Refresh by Run and whenever a
+// token names a key they do not know, so rotated keys are picked up.
+type KeySet struct {
+	URL     string
+	File    string
+	Refresh time.Duration
+	Client  *http.Client
+
+	mu      sync.RWMutex
+	keys    map[string]*rsa.PublicKey
+	fetched time.Time
+}
+
+// Load reads the keys for the first time.
+func (k *KeySet) Load(ctx context.Context) error {
+	var data []byte
+	var err error
+	if k.File != "" {
+		data, err = os.ReadFile(k.File)
+	} else {
+		data, err = k.fetch(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("jwks: %w", err)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return fmt.Errorf("jwks: %w", err)
+	}
+	k.mu.Lock()
+	k.keys = keys
+	k.fetched = time.Now()
+	k.mu.Unlock()
+	return nil
+}
+
+// Run fetches a URL key set every Refresh until ctx is done. Failures keep
+// the keys already known.
+func (k *KeySet) Run(ctx context.Context) {
+	if k.URL == "" || k.Refresh <= 0 {
+		return
+	}
+	ticker := time.NewTicker(k.Refresh)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.Load(ctx); err != nil {
+				log.Print(err)
+			}
+		}
+	}
+}
+
+// Key returns the key with the given ID, or the only key when the token
+// names none.
+func (k *KeySet) Key(kid string) (*rsa.PublicKey, error) {
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+	k.mu.RLock()
+	stale := time.Since(k.fetched) > minRefetch
+	k.mu.RUnlock()
+	if k.URL != "" && stale {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := k.Load(ctx); err != nil {
+			return nil, err
+		}
+		if key, ok := k.lookup(kid); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("jwks: no key %q", kid)
+}
+
+func (k *KeySet) lookup(kid string) (*rsa.PublicKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+func (k *KeySet) fetch(ctx context.Context) ([]byte, error) {
+	client := k.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", k.URL, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// parseJWKS keeps the RSA signing keys of a JWKS document and skips the
+// others, like encryption keys.
+func parseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	keys := map[string]*rsa.PublicKey{}
+	for _, key := range doc.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") || (key.Alg != "" && key.Alg != "RS256") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: modulus: %w", key.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: exponent: %w", key.Kid, err)
+		}
+		keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no RS256 signing keys")
+	}
+	return keys, nil
+}
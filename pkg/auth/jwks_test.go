@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func toJWK(kid string, key *rsa.PublicKey) jwk {
+	return jwk{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func jwksDocument(t *testing.T, keys ...jwk) []byte {
+	t.Helper()
+	data, err := json.Marshal(map[string][]jwk{"keys": keys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestParseJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signing := toJWK("sig", &key.PublicKey)
+	noUse := toJWK("plain", &key.PublicKey)
+	noUse.Use, noUse.Alg = "", ""
+	encryption := toJWK("enc", &key.PublicKey)
+	encryption.Use = "enc"
+	ps256 := toJWK("ps", &key.PublicKey)
+	ps256.Alg = "PS256"
+	ec := jwk{Kty: "EC", Kid: "ec"}
+	badModulus := toJWK("bad", &key.PublicKey)
+	badModulus.N = "***"
+
+	tests := []struct {
+		name     string
+		data     []byte
+		wantKids []string
+		wantErr  string
+	}{
+		{name: "signing keys", data: jwksDocument(t, signing, noUse), wantKids: []string{"plain", "sig"}},
+		{name: "skips other keys", data: jwksDocument(t, signing, encryption, ps256, ec), wantKids: []string{"sig"}},
+		{name: "no signing keys", data: jwksDocument(t, encryption, ec), wantErr: "no RS256 signing keys"},
+		{name: "empty", data: []byte(`{"keys":[]}`), wantErr: "no RS256 signing keys"},
+		{name: "bad modulus", data: jwksDocument(t, badModulus), wantErr: `key "bad": modulus`},
+		{name: "not json", data: []byte(`<html>`), wantErr: "invalid character"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := parseJWKS(tt.data)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseJWKS() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseJWKS() error = %v", err)
+			}
+			if len(keys) != len(tt.wantKids) {
+				t.Fatalf("parseJWKS() = %d keys, want %v", len(keys), tt.wantKids)
+			}
+			for _, kid := range tt.wantKids {
+				if got, ok := keys[kid]; !ok || !got.Equal(&key.PublicKey) {
+					t.Errorf("parseJWKS() key %q = %v, want the generated key", kid, got)
+				}
+			}
+		})
+	}
+}
+
+func TestKeySetFile(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(file, jwksDocument(t, toJWK("k1", &key.PublicKey)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	keys := &KeySet{File: file}
+	if err := keys.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	for _, kid := range []string{"k1", ""} {
+		if got, err := keys.Key(kid); err != nil || !got.Equal(&key.PublicKey) {
+			t.Errorf("Key(%q) = %v, %v, want the key of the file", kid, got, err)
+		}
+	}
+	if _, err := keys.Key("k2"); err == nil {
+		t.Error(`Key("k2") found a key the file does not have`)
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rotated atomic.Bool
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		keys := []jwk{toJWK("old", &oldKey.PublicKey)}
+		if rotated.Load() {
+			keys = append(keys, toJWK("new", &newKey.PublicKey))
+		}
+		w.Write(jwksDocument(t, keys...))
+	}))
+	defer server.Close()
+
+	keys := &KeySet{URL: server.URL}
+	if err := keys.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	rotated.Store(true)
+
+	// fetched just now: an unknown key ID must not hit the server again
+	if _, err := keys.Key("new"); err == nil {
+		t.Fatal(`Key("new") found the rotated key before a refetch`)
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Fatalf("fetches = %d, want 1", got)
+	}
+
+	keys.mu.Lock()
+	keys.fetched = time.Now().Add(-2 * minRefetch)
+	keys.mu.Unlock()
+	got, err := keys.Key("new")
+	if err != nil || !got.Equal(&newKey.PublicKey) {
+		t.Fatalf(`Key("new") = %v, %v, want the rotated key`, got, err)
+	}
+	if got := fetches.Load(); got != 2 {
+		t.Errorf("fetches = %d, want 2", got)
+	}
+}
+
+func TestKeySetLoadStatus(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	keys := &KeySet{URL: server.URL}
+	err := keys.Load(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("Load() error = %v, want the 404 status", err)
+	}
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"fmt"
+	"ibrokers_service/pkg/apperror"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// UserIDKey is the gin context key holding the subject of the token,
+	// which the panic incidents name.
+	UserIDKey = "user_id"
+
+	principalKey = "principal"
+	tokenErrKey  = "auth_error"
+)
+
+// Authenticate reads the bearer token of every request. A valid token
+// makes its Principal available to Require; requests without one pass on
+// anonymously, so routes that need no permission stay open, and an invalid
+// one is only rejected by the routes that do.
+func Authenticate(verifier *Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if header == "" {
+			c.Next()
+			return
+		}
+		scheme, token, ok := strings.Cut(header, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			Reject(c, fmt.Errorf("authorization header is not a bearer token"))
+			c.Next()
+			return
+		}
+		principal, err := verifier.Verify(strings.TrimSpace(token))
+		if err != nil {
+			Reject(c, err)
+		} else {
+			SetPrincipal(c, principal)
+		}
+		c.Next()
+	}
+}
+
+// SetPrincipal authenticates the request as principal, for middleware
+// accepting other credentials than bearer tokens.
+func SetPrincipal(c *gin.Context, principal Principal) {
+	c.Set(principalKey, principal)
+	c.Set(UserIDKey, principal.Subject)
+}
+
+// Reject records that the credentials of the request are invalid, which
+// Require answers with 401.
+func Reject(c *gin.Context, err error) {
+	c.Set(tokenErrKey, err)
+}
+
+// Require answers 401 unless the request carries valid credentials, and
+// 403 unless its principal holds every permission.
+func Require(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := FromContext(c)
+		if !ok {
+			if err, rejected := c.Get(tokenErrKey); rejected {
+				c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+				apperror.Write(c, apperror.ErrInvalidToken.WithDetail("%s", err))
+				return
+			}
+			c.Header("WWW-Authenticate", "Bearer")
+			apperror.Write(c, apperror.ErrUnauthorized)
+			return
+		}
+		for _, permission := range permissions {
+			if !principal.Can(permission) {
+				apperror.Write(c, apperror.ErrForbidden.WithDetail("%s is required", permission))
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// FromContext returns the principal of an authenticated request.
+func FromContext(c *gin.Context) (Principal, bool) {
+	principal, ok := c.Get(principalKey)
+	if !ok {
+		return Principal{}, false
+	}
+	p, ok := principal.(Principal)
+	return p, ok
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequire(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	verifier := &Verifier{
+		Algorithms: []string{HS256},
+		Secret:     secret,
+		Issuer:     "ibrokers",
+		Audience:   "ibrokers-api",
+		Roles:      map[string][]string{"reader": {PermReferenceRead}},
+	}
+	app := gin.New()
+	app.Use(Authenticate(verifier))
+	app.GET("/open", func(c *gin.Context) { c.Status(http.StatusOK) })
+	app.GET("/read", Require(PermReferenceRead), func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(UserIDKey))
+	})
+	app.GET("/write", Require(PermReferenceRead, PermReferenceWrite), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	reader := hs256(t, valid(map[string]any{"roles": []string{"reader"}}))
+	admin := hs256(t, valid(map[string]any{"roles": []string{Admin}}))
+	expired := hs256(t, valid(map[string]any{"exp": 1}))
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		status        int
+		challenge     string
+		body          string
+	}{
+		{name: "open without token", path: "/open", status: http.StatusOK},
+		{name: "open with invalid token", path: "/open", authorization: "Bearer junk", status: http.StatusOK},
+		{name: "no token", path: "/read", status: http.StatusUnauthorized, challenge: "Bearer"},
+		{name: "not bearer", path: "/read", authorization: "Basic dXNlcjpwYXNz", status: http.StatusUnauthorized, challenge: `Bearer error="invalid_token"`},
+		{name: "empty bearer", path: "/read", authorization: "Bearer ", status: http.StatusUnauthorized, challenge: `Bearer error="invalid_token"`},
+		{name: "expired", path: "/read", authorization: "Bearer " + expired, status: http.StatusUnauthorized, challenge: `Bearer error="invalid_token"`},
+		{name: "permitted", path: "/read", authorization: "Bearer " + reader, status: http.StatusOK, body: "user-1"},
+		{name: "scheme is case insensitive", path: "/read", authorization: "bearer " + reader, status: http.StatusOK, body: "user-1"},
+		{name: "missing permission", path: "/write", authorization: "Bearer " + reader, status: http.StatusForbidden},
+		{name: "admin", path: "/write", authorization: "Bearer " + admin, status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			app.ServeHTTP(w, req)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if got := w.Header().Get("WWW-Authenticate"); got != tt.challenge {
+				t.Errorf("WWW-Authenticate = %q, want %q", got, tt.challenge)
+			}
+			if tt.body != "" && w.Body.String() != tt.body {
+				t.Errorf("body = %q, want %q", w.Body, tt.body)
+			}
+		})
+	}
+}
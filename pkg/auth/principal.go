@@ -0,0 +1,30 @@
+// Package auth authenticates bearer JWTs, signed with a shared HS256 secret
+// or with RS256 keys from a JWKS, and enforces the permissions their role
+// and permission claims grant. Other credentials, like the API keys of
+// internal/api_key, authenticate through SetPrincipal.
+package auth
+
+import "slices"
+
+// Permissions checked by the routes. Admin grants every permission.
+const (
+	PermReferenceRead  = "reference:read"
+	PermReferenceWrite = "reference:write"
+	PermOfferPublish   = "offer:publish"
+	Admin              = "admin"
+)
+
+// Known lists every permission, for validating role definitions.
+var Known = []string{PermReferenceRead, PermReferenceWrite, PermOfferPublish, Admin}
+
+// Principal is the caller valid credentials identify.
+type Principal struct {
+	Subject     string
+	Roles       []string
+	Permissions []string
+}
+
+// Can reports whether p holds permission, directly or through admin.
+func (p Principal) Can(permission string) bool {
+	return slices.Contains(p.Permissions, permission) || slices.Contains(p.Permissions, Admin)
+}
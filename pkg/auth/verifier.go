@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Signing algorithms a Verifier can accept.
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+)
+
+// claims are the registered claims plus the roles and permissions. OAuth
+// style space separated scopes count as permissions too.
+type claims struct {
+	jwt.RegisteredClaims
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+	Scope       string   `json:"scope"`
+}
+
+// Verifier checks the signature and the registered claims of tokens and
+// turns them into a Principal.
+type Verifier struct {
+	// Algorithms lists the accepted algorithms, HS256 needs Secret and
+	// RS256 needs Keys.
+	Algorithms []string
+	Secret     []byte
+	Keys       *KeySet
+	// Issuer and Audience are required to match when set.
+	Issuer   string
+	Audience string
+	// Leeway is the clock skew allowed on exp, nbf and iat.
+	Leeway time.Duration
+	// Roles maps role names to the permissions they grant.
+	Roles map[string][]string
+}
+
+// Verify parses token and returns the caller it identifies.
+func (v *Verifier) Verify(token string) (Principal, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(v.Algorithms),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(v.Leeway),
+	}
+	if v.Issuer != "" {
+		options = append(options, jwt.WithIssuer(v.Issuer))
+	}
+	if v.Audience != "" {
+		options = append(options, jwt.WithAudience(v.Audience))
+	}
+
+	var c claims
+	if _, err := jwt.ParseWithClaims(token, &c, v.key, options...); err != nil {
+		return Principal{}, err
+	}
+	if c.Subject == "" {
+		return Principal{}, errors.New("token has no subject")
+	}
+	return v.principal(c), nil
+}
+
+func (v *Verifier) key(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case HS256:
+		if len(v.Secret) == 0 {
+			return nil, errors.New("HS256 is not configured")
+		}
+		return v.Secret, nil
+	case RS256:
+		if v.Keys == nil {
+			return nil, errors.New("RS256 is not configured")
+		}
+		kid, _ := token.Header["kid"].(string)
+		return v.Keys.Key(kid)
+	}
+	return nil, fmt.Errorf("unexpected algorithm %s", token.Method.Alg())
+}
+
+// principal collects the permissions granted directly, through scopes and
+// through roles. The admin role grants admin.
+func (v *Verifier) principal(c claims) Principal {
+	p := Principal{Subject: c.Subject, Roles: c.Roles}
+	p.Permissions = append(p.Permissions, c.Permissions...)
+	p.Permissions = append(p.Permissions, strings.Fields(c.Scope)...)
+	for _, role := range c.Roles {
+		if role == Admin {
+			p.Permissions = append(p.Permissions, Admin)
+		}
+		p.Permissions = append(p.Permissions, v.Roles[role]...)
+	}
+	slices.Sort(p.Permissions)
+	p.Permissions = slices.Compact(p.Permissions)
+	return p
+}
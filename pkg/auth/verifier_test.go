@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var secret = []byte("test-secret")
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, c jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, c)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func hs256(t *testing.T, c jwt.MapClaims) string {
+	return sign(t, jwt.SigningMethodHS256, secret, "", c)
+}
+
+func valid(extra jwt.MapClaims) jwt.MapClaims {
+	c := jwt.MapClaims{
+		"sub": "user-1",
+		"iss": "ibrokers",
+		"aud": "ibrokers-api",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for key, value := range extra {
+		if value == nil {
+			delete(c, key)
+			continue
+		}
+		c[key] = value
+	}
+	return c
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := &KeySet{keys: map[string]*rsa.PublicKey{"k1": &rsaKey.PublicKey}, fetched: time.Now()}
+
+	verifier := &Verifier{
+		Algorithms: []string{HS256, RS256},
+		Secret:     secret,
+		Keys:       keys,
+		Issuer:     "ibrokers",
+		Audience:   "ibrokers-api",
+		Leeway:     30 * time.Second,
+		Roles: map[string][]string{
+			"editor": {PermReferenceRead, PermReferenceWrite},
+			"reader": {PermReferenceRead},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    Principal
+		wantErr string
+	}{
+		{
+			name:  "hs256",
+			token: hs256(t, valid(nil)),
+			want:  Principal{Subject: "user-1"},
+		},
+		{
+			name:  "rs256",
+			token: sign(t, jwt.SigningMethodRS256, rsaKey, "k1", valid(nil)),
+			want:  Principal{Subject: "user-1"},
+		},
+		{
+			name:  "rs256 without kid uses the only key",
+			token: sign(t, jwt.SigningMethodRS256, rsaKey, "", valid(nil)),
+			want:  Principal{Subject: "user-1"},
+		},
+		{
+			name:  "permissions, scopes and roles",
+			token: hs256(t, valid(jwt.MapClaims{"roles": []string{"editor", "reader", "unknown"}, "permissions": []string{PermOfferPublish}, "scope": "reference:read custom"})),
+			want: Principal{
+				Subject:     "user-1",
+				Roles:       []string{"editor", "reader", "unknown"},
+				Permissions: []string{"custom", PermOfferPublish, PermReferenceRead, PermReferenceWrite},
+			},
+		},
+		{
+			name:  "admin role",
+			token: hs256(t, valid(jwt.MapClaims{"roles": []string{Admin}})),
+			want:  Principal{Subject: "user-1", Roles: []string{Admin}, Permissions: []string{Admin}},
+		},
+		{
+			name:  "expired within leeway",
+			token: hs256(t, valid(jwt.MapClaims{"exp": time.Now().Add(-10 * time.Second).Unix()})),
+			want:  Principal{Subject: "user-1"},
+		},
+		{
+			name:    "expired",
+			token:   hs256(t, valid(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()})),
+			wantErr: "token is expired",
+		},
+		{
+			name:    "no expiry",
+			token:   hs256(t, valid(jwt.MapClaims{"exp": nil})),
+			wantErr: "exp claim is required",
+		},
+		{
+			name:    "not valid yet",
+			token:   hs256(t, valid(jwt.MapClaims{"nbf": time.Now().Add(time.Hour).Unix()})),
+			wantErr: "token is not valid yet",
+		},
+		{
+			name:    "wrong issuer",
+			token:   hs256(t, valid(jwt.MapClaims{"iss": "someone"})),
+			wantErr: "token has invalid issuer",
+		},
+		{
+			name:    "wrong audience",
+			token:   hs256(t, valid(jwt.MapClaims{"aud": "other-api"})),
+			wantErr: "token has invalid audience",
+		},
+		{
+			name:    "no subject",
+			token:   hs256(t, valid(jwt.MapClaims{"sub": nil})),
+			wantErr: "token has no subject",
+		},
+		{
+			name:    "wrong secret",
+			token:   sign(t, jwt.SigningMethodHS256, []byte("other"), "", valid(nil)),
+			wantErr: "signature is invalid",
+		},
+		{
+			name:    "wrong rsa key",
+			token:   sign(t, jwt.SigningMethodRS256, otherKey, "k1", valid(nil)),
+			wantErr: "verification error",
+		},
+		{
+			name:    "unknown kid",
+			token:   sign(t, jwt.SigningMethodRS256, rsaKey, "k2", valid(nil)),
+			wantErr: `jwks: no key "k2"`,
+		},
+		{
+			name:    "algorithm not accepted",
+			token:   sign(t, jwt.SigningMethodHS384, secret, "", valid(nil)),
+			wantErr: "signing method HS384 is invalid",
+		},
+		{
+			name:    "unsigned",
+			token:   sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "", valid(nil)),
+			wantErr: "signing method none is invalid",
+		},
+		{
+			name:    "malformed",
+			token:   "not.a.token",
+			wantErr: "token is malformed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifier.Verify(tt.token)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Verify() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Verify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyUnconfiguredAlgorithm(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier := &Verifier{Algorithms: []string{HS256, RS256}, Secret: secret}
+	_, err = verifier.Verify(sign(t, jwt.SigningMethodRS256, rsaKey, "k1", valid(nil)))
+	if err == nil || !strings.Contains(err.Error(), "RS256 is not configured") {
+		t.Errorf("Verify() error = %v, want RS256 is not configured", err)
+	}
+}
+
+func TestCan(t *testing.T) {
+	tests := []struct {
+		permissions []string
+		permission  string
+		want        bool
+	}{
+		{nil, PermReferenceRead, false},
+		{[]string{PermReferenceRead}, PermReferenceRead, true},
+		{[]string{PermReferenceRead}, PermReferenceWrite, false},
+		{[]string{Admin}, PermOfferPublish, true},
+	}
+	for _, tt := range tests {
+		p := Principal{Subject: "s", Permissions: tt.permissions}
+		if got := p.Can(tt.permission); got != tt.want {
+			t.Errorf("%v.Can(%s) = %v, want %v", tt.permissions, tt.permission, got, tt.want)
+		}
+	}
+}
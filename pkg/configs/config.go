@@ -0,0 +1,641 @@
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is read when CONFIG_FILE is not set and the file exists.
+const DefaultFile = "config/config.yaml"
+
+// DefaultProfile is used when APP_ENV is not set.
+const DefaultProfile = "dev"
+
+// Config is the whole service configuration. Every value can come from the
+// YAML file and be overridden by the environment variable in its env tag.
+type Config struct {
+	Env       string          `yaml:"-"`
+	Server    ServerConfig    `yaml:"server"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Minio     MinioConfig     `yaml:"minio"`
+	Loki      LokiConfig      `yaml:"loki"`
+	Log       LogConfig       `yaml:"log"`
+	Tracing   TracingConfig   `yaml:"tracing"`
+	Media     MediaConfig     `yaml:"media"`
+	Auth      AuthConfig      `yaml:"auth"`
+	CORS      CORSConfig      `yaml:"cors"`
+	API       APIConfig       `yaml:"api"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+}
+
+type ServerConfig struct {
+	Port int `yaml:"port" env:"SERVER_PORT"`
+	// RequestTimeout bounds every request, zero means no deadline.
+	// RouteTimeouts overrides it per route, keyed "METHOD /full/path" or
+	// "/full/path", and is read from the file only.
+	RequestTimeout time.Duration            `yaml:"request_timeout" env:"SERVER_REQUEST_TIMEOUT"`
+	RouteTimeouts  map[string]time.Duration `yaml:"route_timeouts"`
+	// HealthTimeout bounds each dependency check of /readyz and /status.
+	HealthTimeout time.Duration `yaml:"health_timeout" env:"SERVER_HEALTH_TIMEOUT"`
+	// Timeouts of the http.Server, zero means none. WriteTimeout must leave
+	// room for the longest request deadline.
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"SERVER_READ_TIMEOUT"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"SERVER_READ_HEADER_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"SERVER_IDLE_TIMEOUT"`
+	// ShutdownTimeout is how long SIGTERM waits for requests in flight and
+	// for flushing logs and traces before the process exits.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT"`
+	// TrustedProxies lists the IPs and CIDRs of the proxies whose
+	// X-Forwarded-For names the client. Empty trusts none, so clients are
+	// known by the address they connect from and cannot pick their own.
+	TrustedProxies []string `yaml:"trusted_proxies" env:"SERVER_TRUSTED_PROXIES"`
+}
+
+func (c ServerConfig) Addr() string {
+	return fmt.Sprintf(":%d", c.Port)
+}
+
+type DatabaseConfig struct {
+	Host     string `yaml:"host" env:"DB_HOST"`
+	Port     int    `yaml:"port" env:"DB_PORT"`
+	User     string `yaml:"user" env:"DB_USER"`
+	Password string `yaml:"password" env:"DB_PASSWORD"`
+	Name     string `yaml:"name" env:"DB_NAME"`
+	SSLMode  string `yaml:"sslmode" env:"DB_SSLMODE"`
+	TimeZone string `yaml:"timezone" env:"DB_TIMEZONE"`
+}
+
+// DSN is the libpq keyword/value connection string. Values are quoted, so
+// passwords with spaces, quotes or backslashes survive; empty ones are left
+// out for the driver's defaults. TimeZone goes first and bare: the gorm
+// driver also picks it out with a regexp that would keep the quotes, and
+// validate only lets known zone names through.
+func (c DatabaseConfig) DSN() string {
+	var parts []string
+	if c.TimeZone != "" {
+		parts = append(parts, "TimeZone="+c.TimeZone)
+	}
+	for _, kv := range [][2]string{
+		{"host", c.Host},
+		{"user", c.User},
+		{"password", c.Password},
+		{"dbname", c.Name},
+		{"port", strconv.Itoa(c.Port)},
+		{"sslmode", c.SSLMode},
+	} {
+		if kv[1] != "" {
+			parts = append(parts, kv[0]+"='"+dsnQuote.Replace(kv[1])+"'")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+var dsnQuote = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+type MinioConfig struct {
+	Endpoint  string `yaml:"endpoint" env:"MINIO_ENDPOINT"`
+	AccessKey string `yaml:"access_key" env:"MINIO_ACCESS_KEY"`
+	SecretKey string `yaml:"secret_key" env:"MINIO_SECRET_KEY"`
+	UseSSL    bool   `yaml:"use_ssl" env:"MINIO_USE_SSL"`
+	// HealthBucket is the bucket the readiness check looks up, so the
+	// credentials need no permission beyond it.
+	HealthBucket string `yaml:"health_bucket" env:"MINIO_HEALTH_BUCKET"`
+}
+
+type LokiConfig struct {
+	URL     string        `yaml:"url" env:"LOKI_URL"`
+	Timeout time.Duration `yaml:"timeout" env:"LOKI_TIMEOUT"`
+}
+
+// Log sinks, see pkg/logging.
+const (
+	SinkStdout = "stdout"
+	SinkFile   = "file"
+	SinkLoki   = "loki"
+)
+
+type LogConfig struct {
+	// Sinks lists where logs go: stdout, file and loki.
+	Sinks []string `yaml:"sinks" env:"LOG_SINKS"`
+	File  string   `yaml:"file" env:"LOG_FILE"`
+	// BufferSize entries are queued per sink; BlockTimeout is how long a
+	// request waits for room in a full queue before its entry is dropped.
+	BufferSize   int           `yaml:"buffer_size" env:"LOG_BUFFER_SIZE"`
+	BlockTimeout time.Duration `yaml:"block_timeout" env:"LOG_BLOCK_TIMEOUT"`
+}
+
+func (c LogConfig) Enabled(sink string) bool {
+	for _, s := range c.Sinks {
+		if s == sink {
+			return true
+		}
+	}
+	return false
+}
+
+// Trace exporters, see pkg/tracing.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+type TracingConfig struct {
+	// Exporter is none, stdout or otlp.
+	Exporter string `yaml:"exporter" env:"TRACING_EXPORTER"`
+	// Endpoint is the OTLP/HTTP collector URL, used by the otlp exporter.
+	Endpoint    string `yaml:"endpoint" env:"TRACING_ENDPOINT"`
+	ServiceName string `yaml:"service_name" env:"TRACING_SERVICE_NAME"`
+	// SampleRatio is the share of new traces recorded, from 0 to 1.
+	SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+}
+
+type MediaConfig struct {
+	// BaseDir is the local directory uploaded files live under, Root the
+	// path prefix they are served with.
+	BaseDir string `yaml:"base_dir" env:"MEDIA_BASE_DIR"`
+	Root    string `yaml:"root" env:"MEDIA_ROOT"`
+}
+
+// Token signing algorithms, see pkg/auth.
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+)
+
+type AuthConfig struct {
+	// Enabled puts bearer token authentication in front of the resources.
+	Enabled bool `yaml:"enabled" env:"AUTH_ENABLED"`
+	// Algorithms lists the accepted token algorithms, HS256 and RS256.
+	// HS256 needs HS256Secret, RS256 a JWKS from JWKSURL or JWKSFile.
+	Algorithms  []string `yaml:"algorithms" env:"AUTH_ALGORITHMS"`
+	HS256Secret string   `yaml:"hs256_secret" env:"AUTH_HS256_SECRET"`
+	JWKSURL     string   `yaml:"jwks_url" env:"AUTH_JWKS_URL"`
+	JWKSFile    string   `yaml:"jwks_file" env:"AUTH_JWKS_FILE"`
+	// JWKSRefresh is how often a JWKS URL is fetched again.
+	JWKSRefresh time.Duration `yaml:"jwks_refresh" env:"AUTH_JWKS_REFRESH"`
+	Issuer      string        `yaml:"issuer" env:"AUTH_ISSUER"`
+	Audience    string        `yaml:"audience" env:"AUTH_AUDIENCE"`
+	Leeway      time.Duration `yaml:"leeway" env:"AUTH_LEEWAY"`
+	// ProtectReads requires reference:read on the list and detail routes,
+	// which are open otherwise.
+	ProtectReads bool `yaml:"protect_reads" env:"AUTH_PROTECT_READS"`
+	// Roles maps the roles of the roles claim to the permissions they
+	// grant, and is read from the file only.
+	Roles map[string][]string `yaml:"roles"`
+}
+
+type CORSConfig struct {
+	AllowOrigins []string `yaml:"allow_origins" env:"CORS_ALLOW_ORIGINS"`
+}
+
+type APIConfig struct {
+	// LegacyFieldNames renders resources with the Go field names used before
+	// the camelCase JSON contract. Clients can still pick per request with
+	// the X-Field-Names header.
+	LegacyFieldNames bool `yaml:"legacy_field_names" env:"API_LEGACY_FIELD_NAMES"`
+	// MaxPageSize caps the limit parameter of the list endpoints.
+	MaxPageSize int `yaml:"max_page_size" env:"API_MAX_PAGE_SIZE"`
+}
+
+// Rate limit backends, see pkg/ratelimit.
+const (
+	BackendMemory = "memory"
+	BackendRedis  = "redis"
+)
+
+type RateLimitConfig struct {
+	Enabled bool `yaml:"enabled" env:"RATE_LIMIT_ENABLED"`
+	// Backend is memory, limiting each instance on its own, or redis,
+	// sharing the limits between instances.
+	Backend string      `yaml:"backend" env:"RATE_LIMIT_BACKEND"`
+	Redis   RedisConfig `yaml:"redis"`
+	// FailOpen lets requests through when the backend fails, instead of
+	// answering 503.
+	FailOpen bool `yaml:"fail_open" env:"RATE_LIMIT_FAIL_OPEN"`
+	// Default applies to every client on the routes without a policy of
+	// their own. Routes is keyed "METHOD /full/path" or "/full/path" like
+	// server.route_timeouts; zero requests means no limit.
+	Default RatePolicy            `yaml:"default"`
+	Routes  map[string]RatePolicy `yaml:"routes"`
+}
+
+// RatePolicy allows Requests per Per on average and Burst at once; a zero
+// Burst is Requests.
+type RatePolicy struct {
+	Requests int           `yaml:"requests" env:"RATE_LIMIT_REQUESTS"`
+	Per      time.Duration `yaml:"per" env:"RATE_LIMIT_PER"`
+	Burst    int           `yaml:"burst" env:"RATE_LIMIT_BURST"`
+}
+
+type RedisConfig struct {
+	Addr     string        `yaml:"addr" env:"REDIS_ADDR"`
+	Password string        `yaml:"password" env:"REDIS_PASSWORD"`
+	DB       int           `yaml:"db" env:"REDIS_DB"`
+	Timeout  time.Duration `yaml:"timeout" env:"REDIS_TIMEOUT"`
+}
+
+func defaults() Config {
+	return Config{
+		Env: DefaultProfile,
+		Server: ServerConfig{
+			Port:              5500,
+			RequestTimeout:    30 * time.Second,
+			HealthTimeout:     2 * time.Second,
+			ReadTimeout:       15 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      90 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			ShutdownTimeout:   25 * time.Second,
+		},
+		Database: DatabaseConfig{
+			Port:     5432,
+			SSLMode:  "disable",
+			TimeZone: "Asia/Tehran",
+		},
+		Loki: LokiConfig{Timeout: 10 * time.Second},
+		Log: LogConfig{
+			Sinks:      []string{SinkStdout, SinkLoki},
+			BufferSize: 1024,
+		},
+		Tracing: TracingConfig{
+			Exporter:    ExporterNone,
+			ServiceName: "ibrokers_service",
+			SampleRatio: 1,
+		},
+		Media: MediaConfig{Root: "/media"},
+		API:   APIConfig{MaxPageSize: 100},
+		RateLimit: RateLimitConfig{
+			Enabled: true,
+			Backend: BackendMemory,
+			Redis:   RedisConfig{Addr: "localhost:6379", Timeout: 200 * time.Millisecond},
+			Default: RatePolicy{Requests: 300, Per: time.Minute, Burst: 60},
+			// probes and scrapes are never limited
+			Routes: map[string]RatePolicy{"/healthz": {}, "/readyz": {}, "/metrics": {}},
+		},
+		Auth: AuthConfig{
+			Enabled:     true,
+			Algorithms:  []string{AlgorithmRS256},
+			JWKSRefresh: 10 * time.Minute,
+			Leeway:      30 * time.Second,
+		},
+	}
+}
+
+// Load builds the configuration from the built in defaults, the "default"
+// section and the APP_ENV profile of the YAML file at path, and finally the
+// environment. An empty path skips the file. The result is not validated,
+// so commands that need only part of it can check just that part.
+func Load(path string) (Config, error) {
+	cfg := defaults()
+	if env := os.Getenv("APP_ENV"); env != "" {
+		cfg.Env = env
+	}
+
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return cfg, err
+		}
+	}
+	if err := loadEnv(reflect.ValueOf(&cfg).Elem()); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
+// loadFile applies the "default" section of the file, then the section named
+// after the profile.
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	var profiles map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &profiles); err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	if node, ok := profiles["default"]; ok {
+		if err := node.Decode(c); err != nil {
+			return fmt.Errorf("config file %s, default: %w", path, err)
+		}
+	}
+	node, ok := profiles[c.Env]
+	if !ok {
+		return fmt.Errorf("config file %s has no profile %q (APP_ENV)", path, c.Env)
+	}
+	if err := node.Decode(c); err != nil {
+		return fmt.Errorf("config file %s, %s: %w", path, c.Env, err)
+	}
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// loadEnv overrides every field that has an env tag and a set variable.
+func loadEnv(v reflect.Value) error {
+	var errs []error
+	for i := 0; i < v.NumField(); i++ {
+		field, value := v.Type().Field(i), v.Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			if err := loadEnv(value); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		name := field.Tag.Get("env")
+		raw, ok := os.LookupEnv(name)
+		if name == "" || !ok {
+			continue
+		}
+
+		switch {
+		case field.Type == durationType:
+			d, err := time.ParseDuration(raw)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a duration", name, raw))
+				continue
+			}
+			value.SetInt(int64(d))
+		case field.Type.Kind() == reflect.String:
+			value.SetString(raw)
+		case field.Type.Kind() == reflect.Int:
+			n, err := strconv.Atoi(raw)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not an integer", name, raw))
+				continue
+			}
+			value.SetInt(int64(n))
+		case field.Type.Kind() == reflect.Float64:
+			f, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a number", name, raw))
+				continue
+			}
+			value.SetFloat(f)
+		case field.Type.Kind() == reflect.Bool:
+			b, err := strconv.ParseBool(raw)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a boolean", name, raw))
+				continue
+			}
+			value.SetBool(b)
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.String:
+			var items []string
+			for _, item := range strings.Split(raw, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			value.Set(reflect.ValueOf(items))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type validator struct {
+	errs []error
+}
+
+func (v *validator) required(value, name, env string) {
+	if strings.TrimSpace(value) == "" {
+		v.errs = append(v.errs, fmt.Errorf("%s is required (%s)", name, env))
+	}
+}
+
+func (v *validator) port(value int, name, env string) {
+	if value < 1 || value > 65535 {
+		v.errs = append(v.errs, fmt.Errorf("%s must be between 1 and 65535, got %d (%s)", name, value, env))
+	}
+}
+
+func (v *validator) absoluteURL(value, name, env string) {
+	if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+		v.errs = append(v.errs, fmt.Errorf("%s must be an absolute URL, got %q (%s)", name, value, env))
+	}
+}
+
+func (v *validator) err(profile string) error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid %s configuration:\n%w", profile, errors.Join(v.errs...))
+}
+
+// Validate reports every missing or malformed setting at once.
+func (c Config) Validate() error {
+	var v validator
+	v.port(c.Server.Port, "server.port", "SERVER_PORT")
+	if c.Server.RequestTimeout < 0 {
+		v.errs = append(v.errs, fmt.Errorf("server.request_timeout must not be negative (SERVER_REQUEST_TIMEOUT)"))
+	}
+	for route, timeout := range c.Server.RouteTimeouts {
+		if timeout <= 0 {
+			v.errs = append(v.errs, fmt.Errorf("server.route_timeouts[%q] must be positive", route))
+		}
+	}
+	if c.Server.HealthTimeout <= 0 {
+		v.errs = append(v.errs, fmt.Errorf("server.health_timeout must be positive (SERVER_HEALTH_TIMEOUT)"))
+	}
+	c.Server.validateTimeouts(&v)
+	for _, proxy := range c.Server.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			v.errs = append(v.errs, fmt.Errorf("server.trusted_proxies: %q is neither an IP nor a CIDR (SERVER_TRUSTED_PROXIES)", proxy))
+		}
+	}
+	c.Database.validate(&v)
+
+	v.required(c.Minio.Endpoint, "minio.endpoint", "MINIO_ENDPOINT")
+	v.required(c.Minio.AccessKey, "minio.access_key", "MINIO_ACCESS_KEY")
+	v.required(c.Minio.SecretKey, "minio.secret_key", "MINIO_SECRET_KEY")
+	v.required(c.Minio.HealthBucket, "minio.health_bucket", "MINIO_HEALTH_BUCKET")
+
+	c.Log.validate(&v)
+	if c.Log.Enabled(SinkLoki) {
+		v.absoluteURL(c.Loki.URL, "loki.url", "LOKI_URL")
+		if c.Loki.Timeout <= 0 {
+			v.errs = append(v.errs, fmt.Errorf("loki.timeout must be positive (LOKI_TIMEOUT)"))
+		}
+	}
+
+	c.Tracing.validate(&v)
+
+	v.required(c.Media.BaseDir, "media.base_dir", "MEDIA_BASE_DIR")
+
+	if c.Auth.Enabled {
+		c.Auth.validate(&v)
+	}
+	if c.API.MaxPageSize < 0 {
+		v.errs = append(v.errs, fmt.Errorf("api.max_page_size must not be negative (API_MAX_PAGE_SIZE)"))
+	}
+	if c.RateLimit.Enabled {
+		c.RateLimit.validate(&v)
+	}
+
+	for _, origin := range c.CORS.AllowOrigins {
+		if origin != "*" {
+			v.absoluteURL(origin, "cors.allow_origins", "CORS_ALLOW_ORIGINS")
+		}
+	}
+	return v.err(c.Env)
+}
+
+// ValidateDatabase checks only the settings needed to reach the database,
+// for commands like migrate.
+func (c Config) ValidateDatabase() error {
+	var v validator
+	c.Database.validate(&v)
+	return v.err(c.Env)
+}
+
+func (c ServerConfig) validateTimeouts(v *validator) {
+	for _, t := range []struct {
+		value     time.Duration
+		name, env string
+	}{
+		{c.ReadTimeout, "server.read_timeout", "SERVER_READ_TIMEOUT"},
+		{c.ReadHeaderTimeout, "server.read_header_timeout", "SERVER_READ_HEADER_TIMEOUT"},
+		{c.WriteTimeout, "server.write_timeout", "SERVER_WRITE_TIMEOUT"},
+		{c.IdleTimeout, "server.idle_timeout", "SERVER_IDLE_TIMEOUT"},
+	} {
+		if t.value < 0 {
+			v.errs = append(v.errs, fmt.Errorf("%s must not be negative (%s)", t.name, t.env))
+		}
+	}
+	if c.ShutdownTimeout <= 0 {
+		v.errs = append(v.errs, fmt.Errorf("server.shutdown_timeout must be positive (SERVER_SHUTDOWN_TIMEOUT)"))
+	}
+	if c.WriteTimeout <= 0 {
+		return
+	}
+	// the connection would be cut before the handler could answer 504
+	longest := c.RequestTimeout
+	for _, timeout := range c.RouteTimeouts {
+		longest = max(longest, timeout)
+	}
+	switch {
+	case c.RequestTimeout == 0:
+		v.errs = append(v.errs, fmt.Errorf("server.write_timeout would cut requests without a deadline, set server.request_timeout too (SERVER_WRITE_TIMEOUT)"))
+	case c.WriteTimeout <= longest:
+		v.errs = append(v.errs, fmt.Errorf("server.write_timeout %v must exceed the longest request timeout %v (SERVER_WRITE_TIMEOUT)", c.WriteTimeout, longest))
+	}
+}
+
+func (c LogConfig) validate(v *validator) {
+	for _, sink := range c.Sinks {
+		if sink != SinkStdout && sink != SinkFile && sink != SinkLoki {
+			v.errs = append(v.errs, fmt.Errorf("log.sinks: unknown sink %q, expected stdout, file or loki (LOG_SINKS)", sink))
+		}
+	}
+	if c.Enabled(SinkFile) {
+		v.required(c.File, "log.file", "LOG_FILE")
+	}
+	if c.BufferSize < 1 {
+		v.errs = append(v.errs, fmt.Errorf("log.buffer_size must be positive, got %d (LOG_BUFFER_SIZE)", c.BufferSize))
+	}
+	if c.BlockTimeout < 0 {
+		v.errs = append(v.errs, fmt.Errorf("log.block_timeout must not be negative (LOG_BLOCK_TIMEOUT)"))
+	}
+}
+
+func (c RateLimitConfig) validate(v *validator) {
+	switch c.Backend {
+	case BackendMemory:
+	case BackendRedis:
+		v.required(c.Redis.Addr, "rate_limit.redis.addr", "REDIS_ADDR")
+		if c.Redis.Timeout <= 0 {
+			v.errs = append(v.errs, fmt.Errorf("rate_limit.redis.timeout must be positive (REDIS_TIMEOUT)"))
+		}
+	default:
+		v.errs = append(v.errs, fmt.Errorf("rate_limit.backend: unknown backend %q, expected memory or redis (RATE_LIMIT_BACKEND)", c.Backend))
+	}
+	c.Default.validate(v, "rate_limit.default")
+	for route, policy := range c.Routes {
+		policy.validate(v, fmt.Sprintf("rate_limit.routes[%q]", route))
+	}
+}
+
+func (p RatePolicy) validate(v *validator, name string) {
+	if p.Requests < 0 || p.Burst < 0 {
+		v.errs = append(v.errs, fmt.Errorf("%s: requests and burst must not be negative", name))
+	}
+	if p.Requests > 0 && p.Per <= 0 {
+		v.errs = append(v.errs, fmt.Errorf("%s: per must be positive", name))
+	}
+}
+
+// permissions are the ones pkg/auth checks, roles may grant only these.
+var permissions = []string{"reference:read", "reference:write", "offer:publish", "admin"}
+
+func (c AuthConfig) validate(v *validator) {
+	if len(c.Algorithms) == 0 {
+		v.errs = append(v.errs, fmt.Errorf("auth.algorithms must not be empty (AUTH_ALGORITHMS)"))
+	}
+	for _, algorithm := range c.Algorithms {
+		switch algorithm {
+		case AlgorithmHS256:
+			if len(c.HS256Secret) < 32 {
+				v.errs = append(v.errs, fmt.Errorf("auth.hs256_secret must be at least 32 bytes for HS256 (AUTH_HS256_SECRET)"))
+			}
+		case AlgorithmRS256:
+			switch {
+			case c.JWKSURL == "" && c.JWKSFile == "":
+				v.errs = append(v.errs, fmt.Errorf("auth.jwks_url or auth.jwks_file is required for RS256 (AUTH_JWKS_URL, AUTH_JWKS_FILE)"))
+			case c.JWKSURL != "" && c.JWKSFile != "":
+				v.errs = append(v.errs, fmt.Errorf("auth.jwks_url and auth.jwks_file are exclusive (AUTH_JWKS_URL, AUTH_JWKS_FILE)"))
+			case c.JWKSURL != "":
+				v.absoluteURL(c.JWKSURL, "auth.jwks_url", "AUTH_JWKS_URL")
+				if c.JWKSRefresh <= 0 {
+					v.errs = append(v.errs, fmt.Errorf("auth.jwks_refresh must be positive (AUTH_JWKS_REFRESH)"))
+				}
+			}
+		default:
+			v.errs = append(v.errs, fmt.Errorf("auth.algorithms: unknown algorithm %q, expected HS256 or RS256 (AUTH_ALGORITHMS)", algorithm))
+		}
+	}
+	if c.Leeway < 0 {
+		v.errs = append(v.errs, fmt.Errorf("auth.leeway must not be negative (AUTH_LEEWAY)"))
+	}
+	for role, granted := range c.Roles {
+		for _, permission := range granted {
+			if !slices.Contains(permissions, permission) {
+				v.errs = append(v.errs, fmt.Errorf("auth.roles[%q]: unknown permission %q", role, permission))
+			}
+		}
+	}
+}
+
+func (c TracingConfig) validate(v *validator) {
+	switch c.Exporter {
+	case ExporterNone, ExporterStdout:
+	case ExporterOTLP:
+		v.absoluteURL(c.Endpoint, "tracing.endpoint", "TRACING_ENDPOINT")
+	default:
+		v.errs = append(v.errs, fmt.Errorf("tracing.exporter: unknown exporter %q, expected none, stdout or otlp (TRACING_EXPORTER)", c.Exporter))
+	}
+	v.required(c.ServiceName, "tracing.service_name", "TRACING_SERVICE_NAME")
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		v.errs = append(v.errs, fmt.Errorf("tracing.sample_ratio must be between 0 and 1, got %g (TRACING_SAMPLE_RATIO)", c.SampleRatio))
+	}
+}
+
+func (c DatabaseConfig) validate(v *validator) {
+	v.required(c.Host, "database.host", "DB_HOST")
+	v.port(c.Port, "database.port", "DB_PORT")
+	v.required(c.User, "database.user", "DB_USER")
+	v.required(c.Name, "database.name", "DB_NAME")
+	if _, err := time.LoadLocation(c.TimeZone); err != nil {
+		v.errs = append(v.errs, fmt.Errorf("database.timezone %q is not a known time zone (DB_TIMEZONE)", c.TimeZone))
+	}
+}
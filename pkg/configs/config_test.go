@@ -0,0 +1,57 @@
+package configs
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestDatabaseDSN(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+	}{
+		{"plain", "secret"},
+		{"space", "two words"},
+		{"keyword inside", "x dbname=other"},
+		{"quote", "it's"},
+		{"backslash", `back\slash`},
+		{"quote and backslash", `\'`},
+		{"equals", "a=b"},
+		{"time zone inside", "x TimeZone=UTC"},
+		{"empty", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := DatabaseConfig{
+				Host:     "db.internal",
+				Port:     5433,
+				User:     "ibrokers",
+				Password: tt.password,
+				Name:     "ibrokers",
+				SSLMode:  "disable",
+				TimeZone: "Asia/Tehran",
+			}
+			// an empty password falls back to PGPASSWORD
+			t.Setenv("PGPASSWORD", "")
+			parsed, err := pgconn.ParseConfig(c.DSN())
+			if err != nil {
+				t.Fatalf("ParseConfig(%q) error = %v", c.DSN(), err)
+			}
+			if parsed.Password != tt.password {
+				t.Errorf("password = %q, want %q", parsed.Password, tt.password)
+			}
+			if parsed.Host != c.Host || parsed.Port != uint16(c.Port) || parsed.User != c.User || parsed.Database != c.Name {
+				t.Errorf("parsed %s:%d %s/%s, want %s:%d %s/%s", parsed.Host, parsed.Port, parsed.User, parsed.Database, c.Host, c.Port, c.User, c.Name)
+			}
+			if got := parsed.RuntimeParams["TimeZone"]; got != c.TimeZone {
+				t.Errorf("TimeZone = %q, want %q", got, c.TimeZone)
+			}
+			// the gorm driver reads the time zone off the DSN by itself
+			if got := regexp.MustCompile("(time_zone|TimeZone)=(.*?)($|&| )").FindStringSubmatch(c.DSN()); got == nil || got[2] != c.TimeZone {
+				t.Errorf("driver time zone = %q, want %q", got, c.TimeZone)
+			}
+		})
+	}
+}
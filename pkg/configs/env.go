@@ -1,12 +0,0 @@
-package configs
-
-const BASE_URL = "/home/mobiniqow/GolandProjects/farshadgo/temp/files"
-const MEDIA_ROOT = "/media"
-
-const MINIO_URL = "localhost:9000"
-const MINIO_ACCESS_KEY = "C2oFM2qlxU9vSIv3eBeb"
-const MINIO_SECRET_KEY = "XyaBfqTogih3Bn60RGSw23xrFDn0Qtr1OOic3yn7"
-const CSRF_SECRET = "asfehweflansdwqie"
-const SESSION_SECRET = "aqwewsaddwwew"
-
-var CORS_URL = []string{"https://foo.com", "http://localhost:5500"}
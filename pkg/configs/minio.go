@@ -1,34 +1,59 @@
 package configs
 
 import (
+	"context"
+	"fmt"
+	"ibrokers_service/pkg/metrics"
+	"ibrokers_service/pkg/requestid"
+	"ibrokers_service/pkg/tracing"
 	"io"
 	"time"
 
 	"github.com/minio/minio-go"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// span attributes of the MinIO calls
+const (
+	bucketKey = attribute.Key("minio.bucket")
+	objectKey = attribute.Key("minio.object")
 )
 
 type Minio struct {
 	Client *minio.Client
 }
 
-func NewMinio(useSsl bool, secret, accessKey, endPoint string) *Minio {
+func NewMinio(useSsl bool, secret, accessKey, endPoint string) (*Minio, error) {
 	minioClient, err := minio.New(endPoint, accessKey, secret, useSsl)
 	if err != nil {
-
+		return nil, fmt.Errorf("minio client for %s: %w", endPoint, err)
 	}
+	// tag every call with the request and the trace it was made for
+	minioClient.SetCustomTransport(requestid.Transport{Base: tracing.Transport{Base: minio.DefaultTransport}})
 	return &Minio{
 		Client: minioClient,
-	}
+	}, nil
 }
 
-func (m *Minio) UploadFile(bucketName, objectName string, reader io.Reader, objectSize int64,
-	opts minio.PutObjectOptions) error {
-	_, err := m.Client.PutObject(bucketName, objectName, reader, objectSize,
+func (m *Minio) UploadFile(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64,
+	opts minio.PutObjectOptions) (err error) {
+	ctx, span := tracing.Start(ctx, "Minio.UploadFile", bucketKey.String(bucketName), objectKey.String(objectName),
+		attribute.Int64("size", objectSize))
+	defer func() { tracing.End(span, err) }()
+
+	start := time.Now()
+	_, err = m.Client.PutObjectWithContext(ctx, bucketName, objectName, reader, objectSize,
 		opts)
+	metrics.ObserveUpload(bucketName, objectSize, time.Since(start), err)
 	return err
 }
 
-func (m *Minio) GetPresignedURL(bucketName, objectName string, expires time.Duration) (string, error) {
+func (m *Minio) GetPresignedURL(ctx context.Context, bucketName, objectName string, expires time.Duration) (_ string, err error) {
+	// minio-go v6 has no PresignedGetObject taking a context, so ctx only
+	// parents the span; signing needs no more than the bucket location
+	_, span := tracing.Start(ctx, "Minio.GetPresignedURL", bucketKey.String(bucketName), objectKey.String(objectName))
+	defer func() { tracing.End(span, err) }()
+
 	url, err := m.Client.PresignedGetObject(bucketName, objectName, expires, nil)
 	if err != nil {
 		return "", err
@@ -36,6 +61,19 @@ func (m *Minio) GetPresignedURL(bucketName, objectName string, expires time.Dura
 	return url.String(), err
 }
 
-func (m *Minio) DeleteFile(bucketName, objectName string) error {
-	return m.Client.RemoveObject(bucketName, objectName)
+func (m *Minio) DeleteFile(ctx context.Context, bucketName, objectName string) (err error) {
+	ctx, span := tracing.Start(ctx, "Minio.DeleteFile", bucketKey.String(bucketName), objectKey.String(objectName))
+	defer func() { tracing.End(span, err) }()
+
+	// minio-go v6 has no RemoveObjectWithContext; the batch delete is its
+	// only removal taking a context, so it gets a batch of one
+	objects := make(chan string, 1)
+	objects <- objectName
+	close(objects)
+	for removeErr := range m.Client.RemoveObjectsWithContext(ctx, bucketName, objects) {
+		if err == nil {
+			err = removeErr.Err
+		}
+	}
+	return err
 }
@@ -0,0 +1,271 @@
+package crud
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/middleware/filter"
+	"ibrokers_service/pkg/middleware/filter/operators"
+	"ibrokers_service/pkg/middleware/naming"
+	"ibrokers_service/pkg/middleware/ordering"
+	"ibrokers_service/pkg/middleware/pagination"
+	"ibrokers_service/pkg/utils/manager"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler serves the REST endpoints of one resource. T is the gorm model,
+// C the request body with pointer fields and R the response body. Bodies
+// are bound to C, never to T, so clients cannot choose the id of a row.
+type Handler[T any, C any, R any] struct {
+	Name        string
+	Service     Service[T]
+	FileManager manager.FileManager
+	ToResponse  func(T) R
+	// Expand maps the keys accepted by ?expand= on the detail endpoint to
+	// the gorm associations they preload.
+	Expand map[string]string
+}
+
+func (h *Handler[T, C, R]) List(ctx *gin.Context) {
+	filters, _ := ctx.Get("filters")
+	h.list(ctx, filters.([]operators.FilterBlock))
+}
+
+// Search lists the resource like List, but reads the filter tree from the
+// JSON body for conditions too long or too nested for a query string.
+func (h *Handler[T, C, R]) Search(ctx *gin.Context) {
+	var req filter.SearchRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		apperror.Write(ctx, invalidBody(err))
+		return
+	}
+	mapper := filter.Mapper{}
+	filters, err := mapper.Blocks(req)
+	if err != nil {
+		apperror.Write(ctx, filter.Invalid(err))
+		return
+	}
+	h.list(ctx, filters)
+}
+
+func (h *Handler[T, C, R]) list(ctx *gin.Context, filters []operators.FilterBlock) {
+	query := ListQuery{
+		Limit:     ctx.MustGet("limit").(int),
+		Page:      ctx.MustGet("page").(int),
+		Keyset:    ctx.GetBool("keyset"),
+		Cursor:    ctx.GetString("cursor"),
+		WithCount: ctx.GetBool("with_count"),
+		Filters:   filters,
+	}
+	orders, _ := ctx.Get("ordering")
+	query.Orders, _ = orders.([]ordering.OrderBlock)
+
+	result, err := h.Service.GetAll(ctx.Request.Context(), query)
+	if err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+
+	_, span := startSpan[T](ctx.Request.Context(), "Handler", "ToResponse")
+	response := make([]R, len(result.Items))
+	for i, item := range result.Items {
+		response[i] = h.ToResponse(item)
+	}
+	items := render(ctx, response)
+	span.End()
+	if query.Keyset {
+		ctx.JSON(http.StatusOK, pagination.GenerateCursorResponse(query.Limit, result.NextCursor, result.PrevCursor, result.Count, items))
+		return
+	}
+	ctx.JSON(http.StatusOK, pagination.GenerateResponse(query.Limit, query.Page, *result.Count, ctx, items))
+}
+
+func (h *Handler[T, C, R]) Details(ctx *gin.Context) {
+	id, ok := h.id(ctx)
+	if !ok {
+		return
+	}
+
+	preloads, err := h.preloads(ctx.Query("expand"))
+	if err != nil {
+		apperror.Write(ctx, apperror.ErrInvalidExpand.WithDetail("%s", err))
+		return
+	}
+
+	item, ok := h.find(ctx, id, preloads...)
+	if !ok {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, render(ctx, h.ToResponse(item)))
+}
+
+func (h *Handler[T, C, R]) Create(ctx *gin.Context) {
+	var req C
+	if err := ctx.ShouldBind(&req); err != nil {
+		apperror.Write(ctx, invalidBody(err))
+		return
+	}
+	// the id stays zero, the database assigns it
+	var item T
+	apply(&item, &req)
+
+	item, err := h.Service.Create(ctx.Request.Context(), item)
+	if err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, render(ctx, h.ToResponse(item)))
+}
+
+func (h *Handler[T, C, R]) Update(ctx *gin.Context) {
+	id, ok := h.id(ctx)
+	if !ok {
+		return
+	}
+	if _, ok := h.find(ctx, id); !ok {
+		return
+	}
+
+	var req C
+	if err := ctx.ShouldBind(&req); err != nil {
+		apperror.Write(ctx, invalidBody(err))
+		return
+	}
+	// a replacement, fields left out of the body are cleared
+	var item T
+	apply(&item, &req)
+	setId(&item, id)
+
+	if err := h.Service.Update(ctx.Request.Context(), item); err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, render(ctx, h.ToResponse(item)))
+}
+
+func (h *Handler[T, C, R]) UpdatePartial(ctx *gin.Context) {
+	id, ok := h.id(ctx)
+	if !ok {
+		return
+	}
+	item, ok := h.find(ctx, id)
+	if !ok {
+		return
+	}
+
+	var req C
+	if err := ctx.ShouldBind(&req); err != nil {
+		apperror.Write(ctx, invalidBody(err))
+		return
+	}
+	apply(&item, &req)
+
+	if err := h.Service.Update(ctx.Request.Context(), item); err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, render(ctx, h.ToResponse(item)))
+}
+
+func (h *Handler[T, C, R]) Delete(ctx *gin.Context) {
+	id, ok := h.id(ctx)
+	if !ok {
+		return
+	}
+	item, ok := h.find(ctx, id)
+	if !ok {
+		return
+	}
+
+	if err := h.Service.Delete(ctx.Request.Context(), item); err != nil {
+		h.writeError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler[T, C, R]) id(ctx *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		apperror.Write(ctx, apperror.ErrInvalidID.WithDetail("%q is not an id", ctx.Param("id")))
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *Handler[T, C, R]) find(ctx *gin.Context, id int, preloads ...string) (T, bool) {
+	item, err := h.Service.FindById(ctx.Request.Context(), id, preloads...)
+	if err != nil {
+		h.writeError(ctx, err)
+		return item, false
+	}
+	return item, true
+}
+
+func (h *Handler[T, C, R]) writeError(ctx *gin.Context, err error) {
+	if errors.Is(err, ErrNotFound) {
+		err = ErrNotFound.WithDetail("%s %s not found", h.Name, ctx.Param("id"))
+	}
+	apperror.Write(ctx, err)
+}
+
+// invalidBody names the field a JSON body got wrong when the decoder says.
+func invalidBody(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return apperror.ErrInvalidBody.WithFields(apperror.Field{
+			Name: typeErr.Field,
+			Code: "invalid_type",
+			Message: apperror.Message{
+				En: fmt.Sprintf("must be of type %s, got %s", typeErr.Type, typeErr.Value),
+				Fa: "نوع مقدار این فیلد درست نیست.",
+			},
+		})
+	}
+	return apperror.ErrInvalidBody.WithDetail("%s", err)
+}
+
+// render returns body as is, or re-keyed with the old Go field names when
+// the naming middleware asked for them.
+func render(ctx *gin.Context, body any) any {
+	if ctx.GetBool("legacy_names") {
+		return naming.Legacy(body)
+	}
+	return body
+}
+
+func (h *Handler[T, C, R]) preloads(expand string) ([]string, error) {
+	var associations []string
+	for _, key := range strings.Split(expand, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		association, ok := h.Expand[key]
+		if !ok {
+			return nil, fmt.Errorf("invalid expand %q, allowed values are: %s", key, strings.Join(h.expandKeys(), ", "))
+		}
+		associations = append(associations, association)
+	}
+	return associations, nil
+}
+
+func (h *Handler[T, C, R]) expandKeys() []string {
+	keys := make([]string, 0, len(h.Expand))
+	for key := range h.Expand {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,100 @@
+package crud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type widget struct {
+	Id    int     `form:"id" json:"id" filter:"id" ordering:"id" gorm:"primary_key"`
+	Name  string  `form:"name" json:"name" filter:"name" ordering:"name"`
+	Price *int    `form:"price" json:"price" filter:"price" ordering:"price"`
+	Note  *string `form:"note" json:"note"`
+}
+
+type widgetRequest struct {
+	Id    *int    `form:"id" json:"id"`
+	Name  *string `form:"name" json:"name"`
+	Price *int    `form:"price" json:"price"`
+	Note  *string `form:"note" json:"note"`
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// every connection would open a database of its own
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	if err := db.AutoMigrate(&widget{}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newTestResource(t *testing.T, db *gorm.DB) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	app := gin.New()
+	NewResource(app.Group("/widget"), Handler[widget, widgetRequest, widget]{
+		Name:       "widget",
+		Service:    Service[widget]{Repository: Repository[widget]{DB: db}},
+		ToResponse: func(w widget) widget { return w },
+	}).V1()
+	return app
+}
+
+func TestHandlerWrites(t *testing.T) {
+	db := newTestDB(t)
+	app := newTestResource(t, db)
+
+	steps := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+		want   string
+	}{
+		{"create ignores the id", http.MethodPost, "/widget/api/v1/", `{"id":5000,"name":"a","price":3}`, http.StatusCreated, `{"id":1,"name":"a","price":3,"note":null}`},
+		{"next create is not blocked", http.MethodPost, "/widget/api/v1/", `{"name":"b","note":"n"}`, http.StatusCreated, `{"id":2,"name":"b","price":null,"note":"n"}`},
+		{"replace keeps the path id", http.MethodPut, "/widget/api/v1/2/", `{"id":1,"name":"c"}`, http.StatusOK, `{"id":2,"name":"c","price":null,"note":null}`},
+		{"replaced row", http.MethodGet, "/widget/api/v1/2/", ``, http.StatusOK, `{"id":2,"name":"c","price":null,"note":null}`},
+		{"other row untouched", http.MethodGet, "/widget/api/v1/1/", ``, http.StatusOK, `{"id":1,"name":"a","price":3,"note":null}`},
+		{"update changes the given fields", http.MethodPatch, "/widget/api/v1/1/", `{"id":7,"price":4}`, http.StatusOK, `{"id":1,"name":"a","price":4,"note":null}`},
+		{"replace missing", http.MethodPut, "/widget/api/v1/9/", `{"name":"x"}`, http.StatusNotFound, ``},
+		{"update missing", http.MethodPatch, "/widget/api/v1/9/", `{"name":"x"}`, http.StatusNotFound, ``},
+		{"bad body", http.MethodPost, "/widget/api/v1/", `{"price":"x"}`, http.StatusBadRequest, ``},
+	}
+	for _, step := range steps {
+		req := httptest.NewRequest(step.method, step.path, strings.NewReader(step.body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		app.ServeHTTP(w, req)
+		if w.Code != step.status {
+			t.Fatalf("%s: status = %d, want %d: %s", step.name, w.Code, step.status, w.Body)
+		}
+		if step.want != "" && w.Body.String() != step.want {
+			t.Errorf("%s: body = %s, want %s", step.name, w.Body, step.want)
+		}
+	}
+
+	var count int64
+	db.Model(&widget{}).Count(&count)
+	if count != 2 {
+		t.Errorf("%d rows, want 2", count)
+	}
+}
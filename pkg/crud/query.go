@@ -0,0 +1,28 @@
+package crud
+
+import (
+	"ibrokers_service/pkg/middleware/filter/operators"
+	"ibrokers_service/pkg/middleware/ordering"
+)
+
+// ListQuery is what a list request asks for. Keyset switches from
+// page/limit to cursor pagination, starting at Cursor or at the first row
+// when it is empty.
+type ListQuery struct {
+	Limit     int
+	Page      int
+	Keyset    bool
+	Cursor    string
+	WithCount bool
+	Filters   []operators.FilterBlock
+	Orders    []ordering.OrderBlock
+}
+
+// ListResult is one page of a list. Count is nil when it was not computed;
+// the cursors are only set in keyset mode.
+type ListResult[T any] struct {
+	Items      []T
+	Count      *int64
+	NextCursor string
+	PrevCursor string
+}
@@ -0,0 +1,62 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+	"ibrokers_service/pkg/apperror"
+)
+
+var ErrInvalidReference = apperror.ErrInvalidReference
+
+// Reference describes one foreign key of T: the request field it comes from,
+// the key accepted by ?expand= and the gorm association to preload. Id returns
+// nil when a nullable key is not set.
+type Reference[T any] struct {
+	Field       string
+	Expand      string
+	Association string
+	Model       interface{}
+	Id          func(T) *int
+}
+
+// CheckReferences builds a Service.Validate hook that rejects items pointing
+// at rows that do not exist, so the client gets a 400 naming every such
+// field instead of a foreign key violation.
+func CheckReferences[T any](rep Repository[T], references []Reference[T]) func(context.Context, T) error {
+	return func(ctx context.Context, item T) error {
+		var fields []apperror.Field
+		for _, ref := range references {
+			id := ref.Id(item)
+			if id == nil {
+				continue
+			}
+			exists, err := rep.Exists(ctx, ref.Model, *id)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				fields = append(fields, apperror.Field{
+					Name: ref.Field,
+					Code: ErrInvalidReference.Code,
+					Message: apperror.Message{
+						En: fmt.Sprintf("no record with id %d", *id),
+						Fa: fmt.Sprintf("رکوردی با شناسه %d وجود ندارد.", *id),
+					},
+				})
+			}
+		}
+		if len(fields) > 0 {
+			return ErrInvalidReference.WithFields(fields...)
+		}
+		return nil
+	}
+}
+
+// ExpandMap maps every ?expand= key of references to the association it preloads.
+func ExpandMap[T any](references []Reference[T]) map[string]string {
+	associations := make(map[string]string, len(references))
+	for _, ref := range references {
+		associations[ref.Expand] = ref.Association
+	}
+	return associations
+}
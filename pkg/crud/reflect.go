@@ -0,0 +1,46 @@
+package crud
+
+import "reflect"
+
+const idField = "Id"
+
+func idOf[T any](item T) int {
+	field := reflect.ValueOf(&item).Elem().FieldByName(idField)
+	if !field.IsValid() {
+		return 0
+	}
+	return int(field.Int())
+}
+
+func setId[T any](item *T, id int) {
+	field := reflect.ValueOf(item).Elem().FieldByName(idField)
+	if field.IsValid() && field.CanSet() {
+		field.SetInt(int64(id))
+	}
+}
+
+// apply copies every non-nil pointer field of req onto the field with the
+// same name in item, dereferencing it unless the model field is a pointer
+// too. The primary key is never overwritten.
+func apply[T any, C any](item *T, req *C) {
+	itemVal := reflect.ValueOf(item).Elem()
+	reqVal := reflect.ValueOf(req).Elem()
+
+	for i := 0; i < reqVal.NumField(); i++ {
+		name := reqVal.Type().Field(i).Name
+		fieldVal := reqVal.Field(i)
+		if name == idField || fieldVal.Kind() != reflect.Ptr || fieldVal.IsNil() {
+			continue
+		}
+		itemField := itemVal.FieldByName(name)
+		if !itemField.IsValid() || !itemField.CanSet() {
+			continue
+		}
+		if itemField.Type() == fieldVal.Type() {
+			// nullable column, keep the pointer
+			itemField.Set(fieldVal)
+		} else {
+			itemField.Set(reflect.Indirect(fieldVal))
+		}
+	}
+}
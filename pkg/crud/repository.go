@@ -0,0 +1,198 @@
+package crud
+
+import (
+	"context"
+	"errors"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/helper"
+	"ibrokers_service/pkg/metrics"
+	"ibrokers_service/pkg/middleware/filter"
+	"ibrokers_service/pkg/middleware/ordering"
+	"ibrokers_service/pkg/middleware/pagination"
+	"ibrokers_service/pkg/tracing"
+	"reflect"
+	"slices"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrNotFound = apperror.ErrNotFound
+
+// Repository is the gorm backed storage shared by every resource. Associations
+// are never written through it, so embedding a related record in a request
+// cannot create or overwrite rows of another table.
+type Repository[T any] struct {
+	DB *gorm.DB
+}
+
+// session binds the queries of a repository method to ctx and names the
+// method for the query metrics.
+func (r *Repository[T]) session(ctx context.Context, method string) *gorm.DB {
+	return r.DB.WithContext(metrics.WithMethod(ctx, method))
+}
+
+func (r *Repository[T]) Create(ctx context.Context, item T) (_ T, err error) {
+	ctx, span := startSpan[T](ctx, "Repository", "Create")
+	defer func() { tracing.End(span, err) }()
+
+	result := r.session(ctx, "Create").Omit(clause.Associations).Create(&item)
+	if result.Error != nil {
+		var zero T
+		return zero, result.Error
+	}
+	return item, nil
+}
+
+// GetAll returns one page of the rows matching the filters, sorted by the
+// requested orderings. Filters, orderings and cursors that do not fit the
+// model are reported as apperror validation errors. Rows are only counted in page
+// mode or when WithCount is set.
+func (r *Repository[T]) GetAll(ctx context.Context, query ListQuery) (_ ListResult[T], err error) {
+	ctx, span := startSpan[T](ctx, "Repository", "GetAll")
+	defer func() { tracing.End(span, err) }()
+
+	var model T
+	var result ListResult[T]
+	_query, err := helper.QueryBuilder(model, r.session(ctx, "GetAll"), query.Filters)
+	if err != nil {
+		return result, invalidQuery(err)
+	}
+	_query = _query.Session(&gorm.Session{})
+	columns, err := helper.OrderColumns(model, r.DB, query.Orders)
+	if err != nil {
+		return result, invalidQuery(err)
+	}
+
+	if !query.Keyset || query.WithCount {
+		var count int64
+		if err := _query.Model(&model).Count(&count).Error; err != nil {
+			return result, err
+		}
+		result.Count = &count
+	}
+
+	if query.Keyset {
+		return r.keysetPage(_query, columns, query, result)
+	}
+	err = _query.Clauses(helper.OrderBy(columns, false)).
+		Scopes(pagination.NewPaginate(query.Limit, query.Page).PaginatedResult).
+		Find(&result.Items).Error
+	return result, err
+}
+
+// keysetPage reads one row more than asked for to learn whether another
+// page follows. Backward pages are read in reverse order and flipped.
+func (r *Repository[T]) keysetPage(_query *gorm.DB, columns []helper.OrderColumn, query ListQuery, result ListResult[T]) (ListResult[T], error) {
+	backward := false
+	if query.Cursor != "" {
+		condition, isBackward, err := helper.KeysetCondition(columns, query.Cursor)
+		if err != nil {
+			return result, invalidQuery(err)
+		}
+		backward = isBackward
+		_query = _query.Where(condition)
+	}
+
+	var items []T
+	err := _query.Clauses(helper.OrderBy(columns, backward)).Limit(query.Limit + 1).Find(&items).Error
+	if err != nil {
+		return result, err
+	}
+	hasMore := len(items) > query.Limit
+	if hasMore {
+		items = items[:query.Limit]
+	}
+	if backward {
+		slices.Reverse(items)
+	}
+	result.Items = items
+	if len(items) == 0 {
+		return result, nil
+	}
+
+	if hasMore || backward {
+		if result.NextCursor, err = helper.CursorOf(columns, reflect.ValueOf(items[len(items)-1]), false); err != nil {
+			return result, err
+		}
+	}
+	if (backward && hasMore) || (!backward && query.Cursor != "") {
+		if result.PrevCursor, err = helper.CursorOf(columns, reflect.ValueOf(items[0]), true); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// Update writes every column of item, zero values included, to the row
+// with its id. Unlike gorm's Save it never inserts: a row that does not
+// exist, or was deleted meanwhile, is reported as ErrNotFound.
+func (r *Repository[T]) Update(ctx context.Context, item T) (err error) {
+	ctx, span := startSpan[T](ctx, "Repository", "Update")
+	defer func() { tracing.End(span, err) }()
+
+	result := r.session(ctx, "Update").Model(new(T)).Where("id = ?", idOf(item)).
+		Select("*").Omit(clause.Associations).Updates(&item)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository[T]) Delete(ctx context.Context, item T) (err error) {
+	ctx, span := startSpan[T](ctx, "Repository", "Delete")
+	defer func() { tracing.End(span, err) }()
+
+	result := r.session(ctx, "Delete").Delete(&item)
+	return result.Error
+}
+
+// FindById loads a record by primary key and preloads the given associations.
+// A missing row is reported as ErrNotFound.
+func (r *Repository[T]) FindById(ctx context.Context, id int, preloads ...string) (_ T, err error) {
+	ctx, span := startSpan[T](ctx, "Repository", "FindById")
+	defer func() { tracing.End(span, err) }()
+
+	var item T
+	query := r.session(ctx, "FindById")
+	for _, association := range preloads {
+		query = query.Preload(association)
+	}
+	result := query.First(&item, id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return item, ErrNotFound
+	} else if result.Error != nil {
+		return item, result.Error
+	}
+	return item, nil
+}
+
+// invalidQuery turns the errors of the filter, ordering and cursor helpers
+// into validation errors naming the rejected parameter.
+func invalidQuery(err error) error {
+	switch {
+	case errors.Is(err, filter.ErrInvalidFilter):
+		return filter.Invalid(err)
+	case errors.Is(err, ordering.ErrInvalidOrdering):
+		return apperror.ErrInvalidOrdering.WithDetail("%s", err)
+	case errors.Is(err, pagination.ErrInvalidCursor):
+		return apperror.ErrInvalidCursor.WithDetail("%s", err)
+	}
+	return err
+}
+
+// Exists reports whether a row with the given id exists in model's table.
+func (r *Repository[T]) Exists(ctx context.Context, model interface{}, id int) (_ bool, err error) {
+	ctx, span := startSpan[T](ctx, "Repository", "Exists")
+	defer func() { tracing.End(span, err) }()
+
+	var count int64
+	result := r.session(ctx, "Exists").Model(model).Where("id = ?", id).Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
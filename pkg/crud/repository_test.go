@@ -0,0 +1,61 @@
+package crud
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRepositoryUpdate(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	rep := Repository[widget]{DB: db}
+	price := 3
+	created, err := rep.Create(ctx, widget{Name: "a", Price: &price})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		item    widget
+		wantErr error
+		want    widget
+	}{
+		{name: "zero values are written", item: widget{Id: created.Id}, want: widget{Id: created.Id}},
+		{name: "fields", item: widget{Id: created.Id, Name: "b", Price: &price}, want: widget{Id: created.Id, Name: "b", Price: &price}},
+		{name: "missing row", item: widget{Id: created.Id + 1, Name: "x"}, wantErr: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rep.Update(ctx, tt.item)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			got, err := rep.FindById(ctx, tt.item.Id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Name != tt.want.Name || (got.Price == nil) != (tt.want.Price == nil) || (got.Price != nil && *got.Price != *tt.want.Price) {
+				t.Errorf("stored %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// a row deleted after it was loaded is not created again
+	if err := rep.Delete(ctx, created); err != nil {
+		t.Fatal(err)
+	}
+	service := Service[widget]{Repository: rep}
+	if err := service.Update(ctx, created); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Service.Update(deleted) error = %v, want %v", err, ErrNotFound)
+	}
+	var count int64
+	db.Model(&widget{}).Count(&count)
+	if count != 0 {
+		t.Errorf("%d rows after updating a deleted one, want 0", count)
+	}
+}
@@ -0,0 +1,56 @@
+package crud
+
+import "github.com/gin-gonic/gin"
+
+// Resource mounts the CRUD routes of a Handler under a router group.
+type Resource[T any, C any, R any] struct {
+	Router  *gin.RouterGroup
+	Handler Handler[T, C, R]
+	Access  Access
+}
+
+// Access guards the routes of a resource: Read runs before the list,
+// search and detail routes, Write before the ones changing records. Nil
+// leaves them open.
+type Access struct {
+	Read  gin.HandlerFunc
+	Write gin.HandlerFunc
+}
+
+func NewResource[T any, C any, R any](router *gin.RouterGroup, handler Handler[T, C, R]) *Resource[T, C, R] {
+	return &Resource[T, C, R]{
+		Router:  router,
+		Handler: handler,
+	}
+}
+
+// WithAccess sets the guards V1 puts in front of the routes.
+func (e *Resource[T, C, R]) WithAccess(access Access) *Resource[T, C, R] {
+	e.Access = access
+	return e
+}
+
+func (e *Resource[T, C, R]) V1() {
+	groupV1 := e.Router.Group("/api/v1")
+	read := guarded(e.Access.Read)
+	write := guarded(e.Access.Write)
+	{
+		groupV1.GET("/", read(e.Handler.List)...)
+		groupV1.POST("/", write(e.Handler.Create)...)
+		groupV1.POST("/search/", read(e.Handler.Search)...)
+		groupV1.GET("/:id/", read(e.Handler.Details)...)
+		groupV1.PUT("/:id/", write(e.Handler.Update)...)
+		groupV1.PATCH("/:id/", write(e.Handler.UpdatePartial)...)
+		groupV1.DELETE("/:id/", write(e.Handler.Delete)...)
+	}
+}
+
+// guarded returns the handler chain of a route, with guard first when set.
+func guarded(guard gin.HandlerFunc) func(gin.HandlerFunc) []gin.HandlerFunc {
+	return func(handler gin.HandlerFunc) []gin.HandlerFunc {
+		if guard == nil {
+			return []gin.HandlerFunc{handler}
+		}
+		return []gin.HandlerFunc{guard, handler}
+	}
+}
@@ -0,0 +1,69 @@
+package crud
+
+import (
+	"context"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/tracing"
+)
+
+// ErrValidation, or another apperror of kind Validation, is what a Validate
+// hook returns for bad input, with the rejected fields, so handlers answer
+// it with 400 instead of 500.
+var ErrValidation = apperror.ErrValidation
+
+type Service[T any] struct {
+	Repository Repository[T]
+	// Validate runs before every create and update when set.
+	Validate func(ctx context.Context, item T) error
+}
+
+func (s *Service[T]) Create(ctx context.Context, item T) (_ T, err error) {
+	ctx, span := startSpan[T](ctx, "Service", "Create")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.validate(ctx, item); err != nil {
+		var zero T
+		return zero, err
+	}
+	return s.Repository.Create(ctx, item)
+}
+
+// Update writes item over the row with its id and returns ErrNotFound when
+// there is none.
+func (s *Service[T]) Update(ctx context.Context, item T) (err error) {
+	ctx, span := startSpan[T](ctx, "Service", "Update")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.validate(ctx, item); err != nil {
+		return err
+	}
+	return s.Repository.Update(ctx, item)
+}
+
+func (s *Service[T]) Delete(ctx context.Context, item T) (err error) {
+	ctx, span := startSpan[T](ctx, "Service", "Delete")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repository.Delete(ctx, item)
+}
+
+func (s *Service[T]) FindById(ctx context.Context, id int, preloads ...string) (_ T, err error) {
+	ctx, span := startSpan[T](ctx, "Service", "FindById")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repository.FindById(ctx, id, preloads...)
+}
+
+func (s *Service[T]) GetAll(ctx context.Context, query ListQuery) (_ ListResult[T], err error) {
+	ctx, span := startSpan[T](ctx, "Service", "GetAll")
+	defer func() { tracing.End(span, err) }()
+
+	return s.Repository.GetAll(ctx, query)
+}
+
+func (s *Service[T]) validate(ctx context.Context, item T) error {
+	if s.Validate == nil {
+		return nil
+	}
+	return s.Validate(ctx, item)
+}
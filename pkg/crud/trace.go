@@ -0,0 +1,18 @@
+package crud
+
+import (
+	"context"
+	"ibrokers_service/pkg/tracing"
+	"path"
+	"reflect"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan opens the span of a Service, Repository or Handler method,
+// named like the aliases of the entity packages, e.g. offer.Repository.GetAll.
+func startSpan[T any](ctx context.Context, layer, method string) (context.Context, trace.Span) {
+	t := reflect.TypeFor[T]()
+	return tracing.Start(ctx, path.Base(t.PkgPath())+"."+layer+"."+method, attribute.String("entity", t.String()))
+}
@@ -0,0 +1,134 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"ibrokers_service/pkg/migrate"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/minio/minio-go"
+	"gorm.io/gorm"
+)
+
+// Database pings the connection pool and reads the server version.
+func Database(db *gorm.DB) Check {
+	return Check{
+		Name:     "database",
+		Critical: true,
+		Run: func(ctx context.Context) (string, error) {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return "", err
+			}
+			if err := sqlDB.PingContext(ctx); err != nil {
+				return "", err
+			}
+			var version string
+			if err := db.WithContext(ctx).Raw("SHOW server_version").Scan(&version).Error; err != nil {
+				return "", err
+			}
+			return version, nil
+		},
+	}
+}
+
+// Migrations fails while the schema is behind the migrations built into the
+// binary, which happens when a newer replica has not migrated yet or a
+// migration was rolled back. The version is the newest applied migration.
+func Migrations(migrator *migrate.Migrator) Check {
+	return Check{
+		Name:     "migrations",
+		Critical: true,
+		Run: func(ctx context.Context) (string, error) {
+			m := *migrator
+			m.DB = migrator.DB.WithContext(ctx)
+			pending, err := m.Pending()
+			if err != nil {
+				return "", err
+			}
+			if len(pending) > 0 {
+				return "", fmt.Errorf("%d pending migrations, starting with %s", len(pending), pending[0])
+			}
+			if len(m.Migrations) == 0 {
+				return "", nil
+			}
+			return strconv.FormatInt(m.Migrations[len(m.Migrations)-1].Version, 10), nil
+		},
+	}
+}
+
+// Minio looks up bucket, which needs both the server and credentials that
+// may read it, but not s3:ListAllMyBuckets as listing the buckets would.
+// minio-go v6 takes no context here, so a hanging call is cut off by the
+// checker but keeps running in the background.
+func Minio(client *minio.Client, bucket string) Check {
+	return Check{
+		Name:     "minio",
+		Critical: true,
+		Run: func(ctx context.Context) (string, error) {
+			done := make(chan error, 1)
+			go func() {
+				exists, err := client.BucketExists(bucket)
+				if err == nil && !exists {
+					err = fmt.Errorf("bucket %q does not exist", bucket)
+				}
+				done <- err
+			}()
+			select {
+			case err := <-done:
+				return "", err
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		},
+	}
+}
+
+// Loki asks the Loki behind pushURL whether it is ready and for its
+// version. Logs are buffered and dropped rather than blocking requests, so
+// Loki is not critical.
+func Loki(pushURL string) Check {
+	return Check{
+		Name: "loki",
+		Run: func(ctx context.Context) (string, error) {
+			base, err := url.Parse(pushURL)
+			if err != nil {
+				return "", err
+			}
+			base.Path, base.RawQuery = "", ""
+
+			if err := get(ctx, base.JoinPath("ready").String(), nil); err != nil {
+				return "", err
+			}
+			var info struct {
+				Version string `json:"version"`
+			}
+			// the version is informative only, older Lokis do not serve it
+			_ = get(ctx, base.JoinPath("loki/api/v1/status/buildinfo").String(), &info)
+			return info.Version, nil
+		},
+	}
+}
+
+// get fails on anything but a 200 and decodes the body into out when set.
+func get(ctx context.Context, target string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", target, resp.Status)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
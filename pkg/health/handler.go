@@ -0,0 +1,81 @@
+package health
+
+import (
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Info describes the running build on /status.
+type Info struct {
+	Service     string    `json:"service"`
+	Environment string    `json:"environment"`
+	Version     string    `json:"version"`
+	Commit      string    `json:"commit,omitempty"`
+	GoVersion   string    `json:"go_version"`
+	StartedAt   time.Time `json:"started_at"`
+}
+
+// NewInfo reads the version and VCS revision the binary was built with.
+func NewInfo(service, environment string) Info {
+	info := Info{
+		Service:     service,
+		Environment: environment,
+		Version:     "unknown",
+		StartedAt:   time.Now(),
+	}
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	info.Version = build.Main.Version
+	info.GoVersion = build.GoVersion
+	for _, setting := range build.Settings {
+		if setting.Key == "vcs.revision" {
+			info.Commit = setting.Value
+		}
+	}
+	return info
+}
+
+// Live answers the liveness probe. It looks at nothing outside the process,
+// so a dependency outage makes the service unready instead of restarting
+// it.
+func Live() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": StatusUp})
+	}
+}
+
+// Ready answers the readiness probe with the report, 503 while a critical
+// check fails.
+func Ready(checker Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		report := checker.Run(c.Request.Context())
+		status := http.StatusOK
+		if report.Status == StatusDown {
+			status = http.StatusServiceUnavailable
+		}
+		c.JSON(status, report)
+	}
+}
+
+type statusResponse struct {
+	Info
+	UptimeSeconds int64 `json:"uptime_seconds"`
+	Report
+}
+
+// Status always answers 200 with the build, the uptime and the latency and
+// version of every dependency, for people rather than orchestrators.
+func Status(checker Checker, info Info) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, statusResponse{
+			Info:          info,
+			UptimeSeconds: int64(time.Since(info.StartedAt).Seconds()),
+			Report:        checker.Run(c.Request.Context()),
+		})
+	}
+}
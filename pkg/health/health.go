@@ -0,0 +1,97 @@
+// Package health answers the liveness, readiness and status probes. A
+// Checker runs the checks of the dependencies concurrently, each under its
+// own deadline; readiness fails when a critical one is down.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	StatusUp       = "up"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// Check probes one dependency. Run returns the version of the dependency
+// when it can tell, and an error when the dependency is unusable.
+type Check struct {
+	Name string
+	// Critical checks make the service unready when they fail; the others
+	// only degrade it.
+	Critical bool
+	Run      func(ctx context.Context) (version string, err error)
+}
+
+// Result is the outcome of one check.
+type Result struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Critical  bool    `json:"critical"`
+	LatencyMs float64 `json:"latency_ms"`
+	Version   string  `json:"version,omitempty"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report is the outcome of all checks: down when a critical check failed,
+// degraded when another one did.
+type Report struct {
+	Status string   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+type Checker struct {
+	Checks []Check
+	// Timeout bounds every check.
+	Timeout time.Duration
+}
+
+// Run runs the checks concurrently and reports them in their order.
+func (c Checker) Run(ctx context.Context) Report {
+	results := make([]Result, len(c.Checks))
+	var wg sync.WaitGroup
+	for i, check := range c.Checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusUp, Checks: results}
+	for _, result := range results {
+		switch {
+		case result.Status == StatusUp:
+		case result.Critical:
+			report.Status = StatusDown
+		case report.Status == StatusUp:
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+func (c Checker) run(ctx context.Context, check Check) Result {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	start := time.Now()
+	version, err := check.Run(ctx)
+	result := Result{
+		Name:      check.Name,
+		Status:    StatusUp,
+		Critical:  check.Critical,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+		Version:   version,
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,146 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func up(name string, critical bool) Check {
+	return Check{Name: name, Critical: critical, Run: func(context.Context) (string, error) { return "1.0", nil }}
+}
+
+func down(name string, critical bool) Check {
+	return Check{Name: name, Critical: critical, Run: func(context.Context) (string, error) { return "", errors.New("refused") }}
+}
+
+// hanging blocks until its context is done, like a dependency that does
+// not answer.
+func hanging(name string, critical bool) Check {
+	return Check{Name: name, Critical: critical, Run: func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}}
+}
+
+func TestCheckerRun(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks []Check
+		status string
+		ready  int
+		// statuses of the checks, in their order
+		results []string
+	}{
+		{
+			name:    "no checks",
+			status:  StatusUp,
+			ready:   http.StatusOK,
+			results: []string{},
+		},
+		{
+			name:    "all up",
+			checks:  []Check{up("postgres", true), up("redis", false)},
+			status:  StatusUp,
+			ready:   http.StatusOK,
+			results: []string{StatusUp, StatusUp},
+		},
+		{
+			name:    "optional down",
+			checks:  []Check{up("postgres", true), down("redis", false)},
+			status:  StatusDegraded,
+			ready:   http.StatusOK,
+			results: []string{StatusUp, StatusDown},
+		},
+		{
+			name:    "critical down",
+			checks:  []Check{down("postgres", true), up("redis", false)},
+			status:  StatusDown,
+			ready:   http.StatusServiceUnavailable,
+			results: []string{StatusDown, StatusUp},
+		},
+		{
+			name:    "critical down after optional down",
+			checks:  []Check{down("redis", false), down("postgres", true)},
+			status:  StatusDown,
+			ready:   http.StatusServiceUnavailable,
+			results: []string{StatusDown, StatusDown},
+		},
+		{
+			name:    "optional times out",
+			checks:  []Check{up("postgres", true), hanging("minio", false)},
+			status:  StatusDegraded,
+			ready:   http.StatusOK,
+			results: []string{StatusUp, StatusDown},
+		},
+		{
+			name:    "critical times out",
+			checks:  []Check{hanging("postgres", true), up("redis", false)},
+			status:  StatusDown,
+			ready:   http.StatusServiceUnavailable,
+			results: []string{StatusDown, StatusUp},
+		},
+	}
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := Checker{Checks: tt.checks, Timeout: 20 * time.Millisecond}
+
+			report := checker.Run(context.Background())
+			if report.Status != tt.status {
+				t.Errorf("Run() status = %s, want %s", report.Status, tt.status)
+			}
+			if len(report.Checks) != len(tt.results) {
+				t.Fatalf("Run() = %d results, want %d", len(report.Checks), len(tt.results))
+			}
+			for i, result := range report.Checks {
+				if result.Name != tt.checks[i].Name || result.Status != tt.results[i] || result.Critical != tt.checks[i].Critical {
+					t.Errorf("result %d = %+v, want %s %s", i, result, tt.checks[i].Name, tt.results[i])
+				}
+				if (result.Status == StatusDown) != (result.Error != "") {
+					t.Errorf("result %d = %+v, want an error exactly when down", i, result)
+				}
+			}
+
+			app := gin.New()
+			app.GET("/ready", Ready(checker))
+			w := httptest.NewRecorder()
+			app.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ready", nil))
+			if w.Code != tt.ready {
+				t.Errorf("Ready() = %d, want %d", w.Code, tt.ready)
+			}
+		})
+	}
+}
+
+func TestCheckerTimeout(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+	checker := Checker{
+		Checks:  []Check{hanging("postgres", true), hanging("redis", false), up("minio", false)},
+		Timeout: timeout,
+	}
+	start := time.Now()
+	report := checker.Run(context.Background())
+	elapsed := time.Since(start)
+
+	// the checks run side by side, each under its own deadline
+	if elapsed < timeout || elapsed > timeout+100*time.Millisecond {
+		t.Errorf("Run() took %v, want about %v", elapsed, timeout)
+	}
+	for _, result := range report.Checks[:2] {
+		if result.Error != context.DeadlineExceeded.Error() {
+			t.Errorf("%s error = %q, want %q", result.Name, result.Error, context.DeadlineExceeded)
+		}
+		if result.LatencyMs < float64(timeout.Milliseconds()) {
+			t.Errorf("%s latency = %vms, want at least the timeout", result.Name, result.LatencyMs)
+		}
+	}
+	if got := report.Checks[2]; got.Status != StatusUp || got.Version != "1.0" {
+		t.Errorf("minio = %+v, want up with its version", got)
+	}
+}
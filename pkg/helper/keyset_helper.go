@@ -0,0 +1,117 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"ibrokers_service/pkg/middleware/pagination"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm/clause"
+)
+
+// OrderSignature identifies an ordering, so a cursor is not reused with a
+// different sort.
+func OrderSignature(columns []OrderColumn) string {
+	parts := make([]string, len(columns))
+	for i, column := range columns {
+		parts[i] = column.Field.DBName
+		if column.Desc {
+			parts[i] = "-" + parts[i]
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// CursorOf builds the cursor that continues after item, or before it when
+// backward is set. item must be a struct of the model the columns belong to.
+func CursorOf(columns []OrderColumn, item reflect.Value, backward bool) (string, error) {
+	cursor := pagination.Cursor{Ordering: OrderSignature(columns), Backward: backward}
+	for _, column := range columns {
+		value, _ := column.Field.ValueOf(context.Background(), item)
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		cursor.Values = append(cursor.Values, raw)
+	}
+	return cursor.Encode(), nil
+}
+
+// KeysetCondition decodes a cursor issued for columns and returns the
+// condition selecting the rows after it (or before it, for a backward
+// cursor) together with the cursor direction.
+func KeysetCondition(columns []OrderColumn, value string) (clause.Expression, bool, error) {
+	cursor, err := pagination.DecodeCursor(value)
+	if err != nil {
+		return nil, false, err
+	}
+	if cursor.Ordering != OrderSignature(columns) || len(cursor.Values) != len(columns) {
+		return nil, false, fmt.Errorf("%w: it was issued for another ordering", pagination.ErrInvalidCursor)
+	}
+
+	values := make([]interface{}, len(columns))
+	for i, column := range columns {
+		if values[i], err = cursorValue(column, cursor.Values[i]); err != nil {
+			return nil, false, err
+		}
+	}
+
+	// (c1 > v1) OR (c1 = v1 AND c2 > v2) OR ...
+	var alternatives []clause.Expression
+	for i, column := range columns {
+		var conditions []clause.Expression
+		for j := 0; j < i; j++ {
+			conditions = append(conditions, equal(columns[j], values[j]))
+		}
+		after := beyond(column, values[i], column.Desc != cursor.Backward)
+		if after == nil {
+			continue
+		}
+		alternatives = append(alternatives, clause.And(append(conditions, after)...))
+	}
+	if len(alternatives) == 0 {
+		return clause.Expr{SQL: "1 = 0"}, cursor.Backward, nil
+	}
+	return clause.Or(alternatives...), cursor.Backward, nil
+}
+
+func cursorValue(column OrderColumn, raw json.RawMessage) (interface{}, error) {
+	if string(raw) == "null" {
+		return nil, nil
+	}
+	value := reflect.New(column.Field.FieldType)
+	if err := json.Unmarshal(raw, value.Interface()); err != nil {
+		return nil, fmt.Errorf("%w: bad %s value", pagination.ErrInvalidCursor, column.Field.DBName)
+	}
+	value = value.Elem()
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil, nil
+		}
+		value = value.Elem()
+	}
+	return value.Interface(), nil
+}
+
+func equal(column OrderColumn, value interface{}) clause.Expression {
+	// clause.Eq renders a nil value as IS NULL
+	return clause.Eq{Column: column.Column(), Value: value}
+}
+
+// beyond selects the values after value in sort direction, treating NULL as
+// larger than any value like Postgres does. It returns nil when nothing can
+// follow.
+func beyond(column OrderColumn, value interface{}, descending bool) clause.Expression {
+	switch {
+	case value == nil && descending:
+		return clause.Neq{Column: column.Column(), Value: nil}
+	case value == nil:
+		return nil
+	case descending:
+		return clause.Lt{Column: column.Column(), Value: value}
+	default:
+		return clause.Or(clause.Gt{Column: column.Column(), Value: value}, clause.Eq{Column: column.Column(), Value: nil})
+	}
+}
@@ -0,0 +1,136 @@
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+	"ibrokers_service/pkg/middleware/ordering"
+	"ibrokers_service/pkg/middleware/pagination"
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+func TestKeysetCondition(t *testing.T) {
+	five := 5
+	asc := []ordering.OrderBlock{{Key: "price"}}
+	desc := []ordering.OrderBlock{{Key: "price", Desc: true}}
+	tests := []struct {
+		name      string
+		orders    []ordering.OrderBlock
+		after     item
+		backward  bool
+		signature string
+		want      string
+	}{
+		{
+			"primary key only", nil, item{Id: 7}, false, "id",
+			`("items"."id" > 7 OR "items"."id" IS NULL)`,
+		},
+		{
+			"ascending", asc, item{Id: 7, Price: &five}, false, "price,id",
+			`(("items"."price" > 5 OR "items"."price" IS NULL) OR ("items"."price" = 5 AND ("items"."id" > 7 OR "items"."id" IS NULL)))`,
+		},
+		{
+			// NULLs sort last ascending, so only other NULLs can follow
+			"ascending after null", asc, item{Id: 7}, false, "price,id",
+			`("items"."price" IS NULL AND ("items"."id" > 7 OR "items"."id" IS NULL))`,
+		},
+		{
+			"descending", desc, item{Id: 7, Price: &five}, false, "-price,id",
+			`("items"."price" < 5 OR ("items"."price" = 5 AND ("items"."id" > 7 OR "items"."id" IS NULL)))`,
+		},
+		{
+			// and first descending, so every value follows them
+			"descending after null", desc, item{Id: 7}, false, "-price,id",
+			`("items"."price" IS NOT NULL OR ("items"."price" IS NULL AND ("items"."id" > 7 OR "items"."id" IS NULL)))`,
+		},
+		{
+			"backward", asc, item{Id: 7, Price: &five}, true, "price,id",
+			`("items"."price" < 5 OR ("items"."price" = 5 AND "items"."id" < 7))`,
+		},
+		{
+			"backward before null", asc, item{Id: 7}, true, "price,id",
+			`("items"."price" IS NOT NULL OR ("items"."price" IS NULL AND "items"."id" < 7))`,
+		},
+	}
+	db := dryRun(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns, err := OrderColumns(item{}, db, tt.orders)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := OrderSignature(columns); got != tt.signature {
+				t.Errorf("OrderSignature() = %q, want %q", got, tt.signature)
+			}
+			cursor, err := CursorOf(columns, reflect.ValueOf(tt.after), tt.backward)
+			if err != nil {
+				t.Fatal(err)
+			}
+			condition, backward, err := KeysetCondition(columns, cursor)
+			if err != nil {
+				t.Fatalf("KeysetCondition() error = %v", err)
+			}
+			if backward != tt.backward {
+				t.Errorf("backward = %v, want %v", backward, tt.backward)
+			}
+			got := toSQL(db, func(tx *gorm.DB) *gorm.DB { return tx.Where(condition) })
+			if want := `SELECT * FROM "items" WHERE ` + tt.want; got != want {
+				t.Errorf("KeysetCondition()\n got %s\nwant %s", got, want)
+			}
+		})
+	}
+}
+
+func TestKeysetConditionNothingFollows(t *testing.T) {
+	db := dryRun(t)
+	columns, err := OrderColumns(item{}, db, []ordering.OrderBlock{{Key: "price"}, {Key: "id"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// without the primary key, no row can follow the last NULL
+	columns = columns[:1]
+	cursor, _ := CursorOf(columns, reflect.ValueOf(item{Id: 7}), false)
+	condition, _, err := KeysetCondition(columns, cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := toSQL(db, func(tx *gorm.DB) *gorm.DB { return tx.Where(condition) })
+	if want := `SELECT * FROM "items" WHERE 1 = 0`; got != want {
+		t.Errorf("KeysetCondition()\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestKeysetConditionInvalidCursor(t *testing.T) {
+	db := dryRun(t)
+	byPrice, _ := OrderColumns(item{}, db, []ordering.OrderBlock{{Key: "price"}})
+	byName, _ := OrderColumns(item{}, db, []ordering.OrderBlock{{Key: "name"}})
+	nameCursor, _ := CursorOf(byName, reflect.ValueOf(item{Id: 1, Name: "a"}), false)
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "%%%"},
+		{"not json", pagination.Cursor{}.Encode()[:2]},
+		{"other ordering", nameCursor},
+		{"value count", pagination.Cursor{Ordering: "price,id", Values: nil}.Encode()},
+		{"value type", pagination.Cursor{Ordering: "price,id", Values: rawValues(`"five"`, `7`)}.Encode()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := KeysetCondition(byPrice, tt.cursor); !errors.Is(err, pagination.ErrInvalidCursor) {
+				t.Errorf("KeysetCondition() error = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
+
+func rawValues(values ...string) []json.RawMessage {
+	raw := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		raw[i] = json.RawMessage(value)
+	}
+	return raw
+}
@@ -0,0 +1,85 @@
+package helper
+
+import (
+	"fmt"
+	"ibrokers_service/pkg/middleware/ordering"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// OrderColumn is a requested sort resolved to its schema field.
+type OrderColumn struct {
+	Field *schema.Field
+	Desc  bool
+}
+
+func (c OrderColumn) Column() clause.Column {
+	return clause.Column{Table: clause.CurrentTable, Name: c.Field.DBName}
+}
+
+// OrderColumns resolves the requested fields that carry an `ordering` tag and
+// always ends with the primary key, so rows with equal sort values keep a
+// stable order across pages.
+func OrderColumns(model interface{}, _query *gorm.DB, orders []ordering.OrderBlock) ([]OrderColumn, error) {
+	stmt := &gorm.Statement{DB: _query}
+	if err := stmt.Parse(model); err != nil {
+		return nil, err
+	}
+	allowed := ordering.GetAllowedOrdering(model)
+	primaryKey := stmt.Schema.PrioritizedPrimaryField
+
+	var columns []OrderColumn
+	sortedByKey := false
+	for _, element := range orders {
+		fieldName, ok := allowed[element.Key]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is not sortable; sortable fields are: %s", ordering.ErrInvalidOrdering, element.Key, strings.Join(sortedKeys(allowed), ", "))
+		}
+		field := stmt.Schema.LookUpField(fieldName)
+		if field == nil || field.DBName == "" {
+			return nil, fmt.Errorf("ordering %s: %s is not a column", element.Key, fieldName)
+		}
+		columns = append(columns, OrderColumn{Field: field, Desc: element.Desc})
+		sortedByKey = sortedByKey || field == primaryKey
+	}
+
+	if primaryKey != nil && !sortedByKey {
+		columns = append(columns, OrderColumn{Field: primaryKey})
+	}
+	return columns, nil
+}
+
+// OrderBy sorts by columns, or exactly the other way round when reverse is
+// set.
+func OrderBy(columns []OrderColumn, reverse bool) clause.OrderBy {
+	var orderBy clause.OrderBy
+	for _, column := range columns {
+		orderBy.Columns = append(orderBy.Columns, clause.OrderByColumn{Column: column.Column(), Desc: column.Desc != reverse})
+	}
+	return orderBy
+}
+
+// OrderBuilder applies the ordering of OrderColumns.
+func OrderBuilder(model interface{}, _query *gorm.DB, orders []ordering.OrderBlock) (*gorm.DB, error) {
+	columns, err := OrderColumns(model, _query, orders)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return _query, nil
+	}
+	return _query.Clauses(OrderBy(columns, false)), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -2,20 +2,173 @@ package helper
 
 import (
 	"fmt"
-	"gorm.io/gorm"
 	"ibrokers_service/pkg/middleware/filter"
 	"ibrokers_service/pkg/middleware/filter/operators"
-	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 )
 
-func QueryBuilder(model interface{}, _query *gorm.DB, filters []operators.FilterBlock) (tx *gorm.DB) {
-	allowedQuery := filter.GetAllowedFilters(model)
+var gormOperator operators.Operators = operators.GormOperator{}
+
+// QueryBuilder applies the filters that match a `filter` tag of model.
+// Column names come from the gorm schema and values are converted to the
+// field type first, so nothing from the request is formatted into SQL.
+// Top level parameters that are not filters, like page or expand, are
+// ignored unless they carry an operator suffix; inside groups every key must
+// be a filter.
+func QueryBuilder(model interface{}, _query *gorm.DB, filters []operators.FilterBlock) (*gorm.DB, error) {
+	if len(filters) == 0 {
+		return _query, nil
+	}
+
+	stmt := &gorm.Statement{DB: _query}
+	if err := stmt.Parse(model); err != nil {
+		return nil, err
+	}
+	b := builder{schema: stmt.Schema, fields: map[string]filter.Field{}}
+	for _, field := range filter.GetFilterFields(model) {
+		b.fields[field.Name] = field
+	}
 
 	for _, element := range filters {
+		expression, err := b.block(element, false)
+		if err != nil {
+			return nil, err
+		}
+		if expression != nil {
+			_query = _query.Where(expression)
+		}
+	}
+	return _query, nil
+}
+
+type builder struct {
+	schema *schema.Schema
+	fields map[string]filter.Field
+}
+
+// block returns the expression of a condition or group, or nil for a
+// parameter that is not a filter and may be skipped.
+func (b builder) block(element operators.FilterBlock, strict bool) (clause.Expression, error) {
+	if element.IsGroup() {
+		var expressions []clause.Expression
+		for _, child := range element.Children {
+			expression, err := b.block(child, true)
+			if err != nil {
+				return nil, err
+			}
+			expressions = append(expressions, expression)
+		}
+		if element.Logic == operators.Or {
+			return gormOperator.Or(expressions...), nil
+		}
+		return gormOperator.And(expressions...), nil
+	}
+
+	field, ok := b.fields[element.Key]
+	if !ok {
+		if strict || element.Operator != operators.Exact {
+			return nil, b.invalid(element, "unknown filter")
+		}
+		return nil, nil
+	}
+	schemaField := b.schema.LookUpField(field.FieldName)
+	if schemaField == nil || schemaField.DBName == "" {
+		return nil, fmt.Errorf("filter %s: %s is not a column", field.Name, field.FieldName)
+	}
+	column := clause.Column{Table: clause.CurrentTable, Name: schemaField.DBName}
+
+	expression, err := build(field, column, element)
+	if err != nil {
+		return nil, b.invalid(element, err.Error())
+	}
+	return expression, nil
+}
 
-		if slices.Contains(allowedQuery, element.Key) {
-			_query = _query.Where(fmt.Sprintf("%s %s ?", element.Key, element.Operator), element.Value)
+func build(field filter.Field, column clause.Column, element operators.FilterBlock) (clause.Expression, error) {
+	if !filter.Supports(field.Type, element.Operator) {
+		if !operators.IsOperator(element.Operator) {
+			return nil, fmt.Errorf("unknown operator %q, expected one of %s", element.Operator, strings.Join(operators.Names, ", "))
 		}
+		return nil, fmt.Errorf("operator %s is not supported on %s fields", element.Operator, filter.TypeName(field.Type))
+	}
+
+	switch element.Operator {
+	case operators.IsNull:
+		isNull, err := strconv.ParseBool(element.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", element.Value)
+		}
+		return gormOperator.IsNull(column, isNull), nil
+	case operators.Contains:
+		return gormOperator.Contains(column, element.Value), nil
+	case operators.IContains:
+		return gormOperator.IContains(column, element.Value), nil
+	case operators.StartsWith:
+		return gormOperator.StartsWith(column, element.Value), nil
+	case operators.In:
+		values, err := parseList(field, element.Value)
+		if err != nil {
+			return nil, err
+		}
+		return gormOperator.In(column, values), nil
+	case operators.Between:
+		values, err := parseList(field, element.Value)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != 2 {
+			return nil, fmt.Errorf("between expects two comma separated values")
+		}
+		return gormOperator.Between(column, values[0], values[1]), nil
+	}
+
+	value, err := filter.ParseValue(field.Type, element.Value)
+	if err != nil {
+		return nil, err
+	}
+	switch element.Operator {
+	case operators.Ne:
+		return gormOperator.NotEqual(column, value), nil
+	case operators.Gt:
+		return gormOperator.GreaterThan(column, value), nil
+	case operators.Gte:
+		return gormOperator.GreaterThanEqual(column, value), nil
+	case operators.Lt:
+		return gormOperator.LessThan(column, value), nil
+	case operators.Lte:
+		return gormOperator.LessThanEqual(column, value), nil
+	default:
+		return gormOperator.Equal(column, value), nil
+	}
+}
+
+func parseList(field filter.Field, raw string) ([]interface{}, error) {
+	var values []interface{}
+	for _, part := range strings.Split(raw, ",") {
+		value, err := filter.ParseValue(field.Type, part)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func (b builder) invalid(element operators.FilterBlock, reason string) error {
+	key := element.Key
+	if element.Operator != operators.Exact {
+		key += "__" + element.Operator
+	}
+	allowed := make([]string, 0, len(b.fields))
+	for name, field := range b.fields {
+		allowed = append(allowed, fmt.Sprintf("%s (%s)", name, filter.TypeName(field.Type)))
 	}
-	return _query
+	sort.Strings(allowed)
+	return &filter.Error{Key: key, Reason: reason, Allowed: allowed}
 }
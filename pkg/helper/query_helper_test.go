@@ -0,0 +1,131 @@
+package helper
+
+import (
+	"errors"
+	"ibrokers_service/pkg/middleware/filter"
+	"ibrokers_service/pkg/middleware/filter/operators"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type item struct {
+	Id     int    `gorm:"primary_key" filter:"id" ordering:"id"`
+	Name   string `filter:"name" ordering:"name"`
+	Price  *int   `filter:"price" ordering:"price"`
+	Active bool   `filter:"active"`
+	Note   string
+}
+
+// dryRun returns a Postgres DB that renders statements without connecting.
+func dryRun(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+		Logger:               logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// toSQL renders the SELECT that build makes of a query on items.
+func toSQL(db *gorm.DB, build func(*gorm.DB) *gorm.DB) string {
+	return db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return build(tx.Model(&item{})).Find(&[]item{})
+	})
+}
+
+func cond(key, operator, value string) operators.FilterBlock {
+	return operators.FilterBlock{Key: key, Operator: operator, Value: value}
+}
+
+func TestQueryBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []operators.FilterBlock
+		want    string
+	}{
+		{"none", nil, `SELECT * FROM "items"`},
+		{"exact", []operators.FilterBlock{cond("name", operators.Exact, "a")}, `SELECT * FROM "items" WHERE "items"."name" = 'a'`},
+		{"blocks are anded", []operators.FilterBlock{cond("price", operators.Gte, "5"), cond("price", operators.Lt, "9")}, `SELECT * FROM "items" WHERE "items"."price" >= 5 AND "items"."price" < 9`},
+		{"ne", []operators.FilterBlock{cond("active", operators.Ne, "true")}, `SELECT * FROM "items" WHERE "items"."active" <> true`},
+		{"in", []operators.FilterBlock{cond("id", operators.In, "1, 2,3")}, `SELECT * FROM "items" WHERE "items"."id" IN (1,2,3)`},
+		{"between", []operators.FilterBlock{cond("price", operators.Between, "1,2")}, `SELECT * FROM "items" WHERE "items"."price" BETWEEN 1 AND 2`},
+		{"isnull", []operators.FilterBlock{cond("price", operators.IsNull, "true")}, `SELECT * FROM "items" WHERE "items"."price" IS NULL`},
+		{"contains escapes wildcards", []operators.FilterBlock{cond("name", operators.Contains, "50%_off")}, `SELECT * FROM "items" WHERE "items"."name" LIKE '%50\%\_off%'`},
+		{"icontains folds arabic letters", []operators.FilterBlock{cond("name", operators.IContains, "علي")}, `SELECT * FROM "items" WHERE REPLACE(REPLACE(LOWER("items"."name"), 'ي', 'ی'), 'ك', 'ک') LIKE '%علی%'`},
+		{"startswith", []operators.FilterBlock{cond("name", operators.StartsWith, "ab")}, `SELECT * FROM "items" WHERE "items"."name" LIKE 'ab%'`},
+		{"other parameters are skipped", []operators.FilterBlock{cond("page", operators.Exact, "2"), cond("note", operators.Exact, "x")}, `SELECT * FROM "items"`},
+		{
+			"groups",
+			[]operators.FilterBlock{operators.Group(operators.Or, []operators.FilterBlock{
+				cond("id", operators.Exact, "1"),
+				operators.Group(operators.And, []operators.FilterBlock{cond("name", operators.Exact, "x"), cond("price", operators.Gt, "3")}),
+			})},
+			`SELECT * FROM "items" WHERE ("items"."id" = 1 OR ("items"."name" = 'x' AND "items"."price" > 3))`,
+		},
+	}
+	db := dryRun(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			got := toSQL(db, func(tx *gorm.DB) *gorm.DB {
+				var query *gorm.DB
+				if query, err = QueryBuilder(item{}, tx, tt.filters); err != nil {
+					return tx
+				}
+				return query
+			})
+			if err != nil {
+				t.Fatalf("QueryBuilder() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("QueryBuilder()\n got %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryBuilderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []operators.FilterBlock
+		key     string
+		reason  string
+	}{
+		{"unknown key with operator", []operators.FilterBlock{cond("size", operators.Gt, "1")}, "size__gt", "unknown filter"},
+		{"unknown key in group", []operators.FilterBlock{operators.Group(operators.Or, []operators.FilterBlock{cond("id", operators.Exact, "1"), cond("size", operators.Exact, "1")})}, "size", "unknown filter"},
+		{"unknown operator", []operators.FilterBlock{cond("price", "near", "1")}, "price__near", `unknown operator "near", expected one of exact, ne, gt, gte, lt, lte, in, contains, icontains, startswith, isnull, between`},
+		{"unsupported operator", []operators.FilterBlock{cond("active", operators.Contains, "t")}, "active__contains", "operator contains is not supported on bool fields"},
+		{"bad value", []operators.FilterBlock{cond("price", operators.Exact, "abc")}, "price", `"abc" is not an integer`},
+		{"bad list value", []operators.FilterBlock{cond("id", operators.In, "1,x")}, "id__in", `"x" is not an integer`},
+		{"between needs two values", []operators.FilterBlock{cond("price", operators.Between, "1,2,3")}, "price__between", "between expects two comma separated values"},
+		{"isnull needs a boolean", []operators.FilterBlock{cond("price", operators.IsNull, "maybe")}, "price__isnull", `"maybe" is not a boolean`},
+	}
+	db := dryRun(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := QueryBuilder(item{}, db.Model(&item{}), tt.filters)
+			var filterErr *filter.Error
+			if !errors.As(err, &filterErr) {
+				t.Fatalf("QueryBuilder() error = %v, want a *filter.Error", err)
+			}
+			if !errors.Is(err, filter.ErrInvalidFilter) {
+				t.Errorf("error does not wrap ErrInvalidFilter")
+			}
+			if filterErr.Key != tt.key || filterErr.Reason != tt.reason {
+				t.Errorf("error = %s: %s, want %s: %s", filterErr.Key, filterErr.Reason, tt.key, tt.reason)
+			}
+			want := []string{"active (bool)", "id (int)", "name (string)", "price (int)"}
+			if strings.Join(filterErr.Allowed, ", ") != strings.Join(want, ", ") {
+				t.Errorf("Allowed = %v, want %v", filterErr.Allowed, want)
+			}
+		})
+	}
+}
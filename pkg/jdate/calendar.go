@@ -0,0 +1,128 @@
+package jdate
+
+import "fmt"
+
+// The conversion follows the jalaali-js algorithm (Borkowski), which is exact
+// for Jalali years -61 to 3177.
+
+var breaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// ToGregorian converts a Jalali date to its Gregorian equivalent.
+func ToGregorian(jy, jm, jd int) (gy, gm, gd int) {
+	return d2g(j2d(jy, jm, jd))
+}
+
+// ToJalali converts a Gregorian date to its Jalali equivalent.
+func ToJalali(gy, gm, gd int) (jy, jm, jd int) {
+	return d2j(g2d(gy, gm, gd))
+}
+
+// IsLeap reports whether jy is a leap year in the Jalali calendar.
+func IsLeap(jy int) bool {
+	leap, _, _ := jalCal(jy)
+	return leap == 0
+}
+
+// MonthLength returns the number of days in month jm of year jy.
+func MonthLength(jy, jm int) int {
+	switch {
+	case jm <= 6:
+		return 31
+	case jm <= 11:
+		return 30
+	case IsLeap(jy):
+		return 30
+	default:
+		return 29
+	}
+}
+
+// Valid reports whether jy/jm/jd is a date of the Jalali calendar.
+func Valid(jy, jm, jd int) error {
+	if jy < breaks[0] || jy >= breaks[len(breaks)-1] {
+		return fmt.Errorf("jdate: year %d out of range", jy)
+	}
+	if jm < 1 || jm > 12 {
+		return fmt.Errorf("jdate: invalid month %d", jm)
+	}
+	if jd < 1 || jd > MonthLength(jy, jm) {
+		return fmt.Errorf("jdate: invalid day %d for %d/%02d", jd, jy, jm)
+	}
+	return nil
+}
+
+// jalCal returns the leap status of jy (0 means leap), the Gregorian year in
+// which jy starts and the day in March on which Farvardin 1st falls.
+func jalCal(jy int) (leap, gy, march int) {
+	gy = jy + 621
+	leapJ := -14
+	jp := breaks[0]
+	jump := 0
+	for i := 1; i < len(breaks); i++ {
+		jm := breaks[i]
+		jump = jm - jp
+		if jy < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := jy - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, gy, march
+}
+
+func j2d(jy, jm, jd int) int {
+	_, gy, march := jalCal(jy)
+	return g2d(gy, 3, march) + (jm-1)*31 - jm/7*(jm-7) + jd - 1
+}
+
+func d2j(jdn int) (jy, jm, jd int) {
+	gy, _, _ := d2g(jdn)
+	jy = gy - 621
+	leap, _, march := jalCal(jy)
+	k := jdn - g2d(gy, 3, march)
+	if k >= 0 {
+		if k <= 185 {
+			return jy, 1 + k/31, k%31 + 1
+		}
+		k -= 186
+	} else {
+		jy--
+		k += 179
+		if leap == 1 {
+			k++
+		}
+	}
+	return jy, 7 + k/30, k%30 + 1
+}
+
+// g2d and d2g convert between Gregorian dates and Julian day numbers.
+func g2d(gy, gm, gd int) int {
+	d := (gy+(gm-8)/6+100100)*1461/4 + (153*((gm+9)%12)+2)/5 + gd - 34840408
+	return d - (gy+100100+(gm-8)/6)/100*3/4 + 752
+}
+
+func d2g(jdn int) (gy, gm, gd int) {
+	j := 4*jdn + 139361631
+	j += (4*jdn+183187720)/146097*3/4*4 - 3908
+	i := j%1461/4*5 + 308
+	gd = i%153/5 + 1
+	gm = i/153%12 + 1
+	gy = j/1461 - 100100 + (8-gm)/6
+	return gy, gm, gd
+}
@@ -0,0 +1,99 @@
+package jdate
+
+import "testing"
+
+func TestConversion(t *testing.T) {
+	tests := []struct {
+		jy, jm, jd int
+		gy, gm, gd int
+	}{
+		{1354, 1, 1, 1975, 3, 21},
+		{1399, 12, 30, 2021, 3, 20},
+		{1400, 1, 1, 2021, 3, 21},
+		{1402, 12, 29, 2024, 3, 19},
+		{1403, 1, 1, 2024, 3, 20},
+		{1403, 6, 31, 2024, 9, 21},
+		{1403, 7, 1, 2024, 9, 22},
+		{1403, 7, 15, 2024, 10, 6},
+		{1403, 10, 11, 2024, 12, 31},
+		{1403, 10, 12, 2025, 1, 1},
+		{1403, 12, 30, 2025, 3, 20},
+		{1404, 1, 1, 2025, 3, 21},
+		{1407, 12, 29, 2029, 3, 19},
+		{1408, 1, 1, 2029, 3, 20},
+		{1408, 12, 30, 2030, 3, 20},
+	}
+	for _, tt := range tests {
+		gy, gm, gd := ToGregorian(tt.jy, tt.jm, tt.jd)
+		if gy != tt.gy || gm != tt.gm || gd != tt.gd {
+			t.Errorf("ToGregorian(%d/%02d/%02d) = %d-%02d-%02d, want %d-%02d-%02d", tt.jy, tt.jm, tt.jd, gy, gm, gd, tt.gy, tt.gm, tt.gd)
+		}
+		jy, jm, jd := ToJalali(tt.gy, tt.gm, tt.gd)
+		if jy != tt.jy || jm != tt.jm || jd != tt.jd {
+			t.Errorf("ToJalali(%d-%02d-%02d) = %d/%02d/%02d, want %d/%02d/%02d", tt.gy, tt.gm, tt.gd, jy, jm, jd, tt.jy, tt.jm, tt.jd)
+		}
+	}
+}
+
+func TestIsLeap(t *testing.T) {
+	tests := []struct {
+		jy   int
+		want bool
+	}{
+		{1395, true},
+		{1398, false},
+		{1399, true},
+		{1400, false},
+		{1403, true},
+		{1404, false},
+		{1407, false},
+		{1408, true},
+	}
+	for _, tt := range tests {
+		if got := IsLeap(tt.jy); got != tt.want {
+			t.Errorf("IsLeap(%d) = %v, want %v", tt.jy, got, tt.want)
+		}
+	}
+}
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		jy, jm, jd int
+		wantErr    string
+	}{
+		{1403, 1, 31, ""},
+		{1403, 7, 30, ""},
+		{1403, 12, 30, ""},
+		{1403, 7, 31, "jdate: invalid day 31 for 1403/07"},
+		{1404, 12, 30, "jdate: invalid day 30 for 1404/12"},
+		{1403, 0, 1, "jdate: invalid month 0"},
+		{1403, 13, 1, "jdate: invalid month 13"},
+		{1403, 1, 0, "jdate: invalid day 0 for 1403/01"},
+		{3178, 1, 1, "jdate: year 3178 out of range"},
+		{-62, 1, 1, "jdate: year -62 out of range"},
+	}
+	for _, tt := range tests {
+		err := Valid(tt.jy, tt.jm, tt.jd)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tt.wantErr {
+			t.Errorf("Valid(%d, %d, %d) = %q, want %q", tt.jy, tt.jm, tt.jd, got, tt.wantErr)
+		}
+	}
+}
+
+// Every day of a few centuries converts there and back to itself.
+func TestRoundTrip(t *testing.T) {
+	for jdn := g2d(1900, 1, 1); jdn <= g2d(2100, 12, 31); jdn++ {
+		gy, gm, gd := d2g(jdn)
+		jy, jm, jd := ToJalali(gy, gm, gd)
+		if err := Valid(jy, jm, jd); err != nil {
+			t.Fatalf("ToJalali(%d-%02d-%02d) = invalid %d/%02d/%02d: %v", gy, gm, gd, jy, jm, jd, err)
+		}
+		if y, m, d := ToGregorian(jy, jm, jd); y != gy || m != gm || d != gd {
+			t.Fatalf("%d-%02d-%02d -> %d/%02d/%02d -> %d-%02d-%02d", gy, gm, gd, jy, jm, jd, y, m, d)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package jdate
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is the textual form of a Date on the wire.
+const Format = "YYYY/MM/DD"
+
+// Date is a calendar day that reads and writes as Jalali (1403/07/15) and is
+// stored in Postgres as a date column. The zero Date is NULL.
+type Date struct {
+	t time.Time
+}
+
+var persianDigits = strings.NewReplacer(
+	"۰", "0", "۱", "1", "۲", "2", "۳", "3", "۴", "4",
+	"۵", "5", "۶", "6", "۷", "7", "۸", "8", "۹", "9",
+	"٠", "0", "١", "1", "٢", "2", "٣", "3", "٤", "4",
+	"٥", "5", "٦", "6", "٧", "7", "٨", "8", "٩", "9",
+)
+
+// New returns the Date for the Jalali day jy/jm/jd.
+func New(jy, jm, jd int) (Date, error) {
+	if err := Valid(jy, jm, jd); err != nil {
+		return Date{}, err
+	}
+	gy, gm, gd := ToGregorian(jy, jm, jd)
+	return Date{t: time.Date(gy, time.Month(gm), gd, 0, 0, 0, 0, time.UTC)}, nil
+}
+
+// FromTime returns the Date t falls on in t's location.
+func FromTime(t time.Time) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+	y, m, d := t.Date()
+	return Date{t: time.Date(y, m, d, 0, 0, 0, 0, time.UTC)}
+}
+
+// Parse reads a Jalali date written as 1403/07/15 or 1403-07-15, with Latin
+// or Persian digits.
+func Parse(s string) (Date, error) {
+	s = persianDigits.Replace(strings.TrimSpace(s))
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '/' || r == '-' })
+	if len(parts) != 3 {
+		return Date{}, fmt.Errorf("jdate: %q is not a date like %s", s, Format)
+	}
+	var ymd [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return Date{}, fmt.Errorf("jdate: %q is not a date like %s", s, Format)
+		}
+		ymd[i] = n
+	}
+	return New(ymd[0], ymd[1], ymd[2])
+}
+
+func (d Date) IsZero() bool {
+	return d.t.IsZero()
+}
+
+// Time returns the Gregorian midnight (UTC) of d.
+func (d Date) Time() time.Time {
+	return d.t
+}
+
+// Jalali returns the Jalali year, month and day of d.
+func (d Date) Jalali() (jy, jm, jd int) {
+	return ToJalali(d.t.Year(), int(d.t.Month()), d.t.Day())
+}
+
+// String formats d as 1403/07/15.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	jy, jm, jd := d.Jalali()
+	return fmt.Sprintf("%04d/%02d/%02d", jy, jm, jd)
+}
+
+// Gregorian formats d as 2024-10-06.
+func (d Date) Gregorian() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.t.Format(time.DateOnly)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*d = Date{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("jdate: expected a string like %q", Format)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalParam lets gin bind a Date from a form or query value.
+func (d *Date) UnmarshalParam(param string) error {
+	return d.UnmarshalText([]byte(param))
+}
+
+func (d *Date) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*d = Date{}
+	case time.Time:
+		*d = FromTime(v)
+	case string:
+		return d.scanString(v)
+	case []byte:
+		return d.scanString(string(v))
+	default:
+		return fmt.Errorf("jdate: cannot scan %T", value)
+	}
+	return nil
+}
+
+func (d *Date) scanString(s string) error {
+	t, err := time.Parse(time.DateOnly, s[:min(len(s), len(time.DateOnly))])
+	if err != nil {
+		return fmt.Errorf("jdate: cannot scan %q: %w", s, err)
+	}
+	*d = FromTime(t)
+	return nil
+}
+
+// Value writes the zero date as NULL, so the columns of Date fields must be
+// nullable even when the field is not a pointer.
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.t, nil
+}
+
+// GormDataType makes gorm create the column as date.
+func (Date) GormDataType() string {
+	return "date"
+}
@@ -0,0 +1,157 @@
+package jdate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func mustNew(t *testing.T, jy, jm, jd int) Date {
+	t.Helper()
+	d, err := New(jy, jm, jd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      string
+		gregorian string
+		wantErr   bool
+	}{
+		{input: "1403/07/15", want: "1403/07/15", gregorian: "2024-10-06"},
+		{input: "1403-07-15", want: "1403/07/15", gregorian: "2024-10-06"},
+		{input: " 1403/7/5 ", want: "1403/07/05", gregorian: "2024-09-26"},
+		{input: "۱۴۰۳/۰۷/۱۵", want: "1403/07/15", gregorian: "2024-10-06"},
+		{input: "١٤٠٣/٠٧/١٥", want: "1403/07/15", gregorian: "2024-10-06"},
+		{input: "1403/12/30", want: "1403/12/30", gregorian: "2025-03-20"},
+		{input: "1404/12/30", wantErr: true},
+		{input: "1403/07", wantErr: true},
+		{input: "1403/07/15/1", wantErr: true},
+		{input: "1403/x/15", wantErr: true},
+		{input: "1403/07/15th", wantErr: true},
+	}
+	for _, tt := range tests {
+		d, err := Parse(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) = %s, want an error", tt.input, d)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.input, err)
+			continue
+		}
+		if d.String() != tt.want || d.Gregorian() != tt.gregorian {
+			t.Errorf("Parse(%q) = %s (%s), want %s (%s)", tt.input, d, d.Gregorian(), tt.want, tt.gregorian)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type body struct {
+		Date    Date  `json:"date"`
+		Pointer *Date `json:"pointer"`
+	}
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "dates", input: `{"date":"1403/07/15","pointer":"۱۴۰۳-۰۱-۰۱"}`, want: `{"date":"1403/07/15","pointer":"1403/01/01"}`},
+		{name: "nulls", input: `{"date":null,"pointer":null}`, want: `{"date":null,"pointer":null}`},
+		{name: "empty string", input: `{"date":""}`, want: `{"date":null,"pointer":null}`},
+		{name: "number", input: `{"date":14030715}`, wantErr: true},
+		{name: "invalid day", input: `{"date":"1403/07/31"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b body
+			err := json.Unmarshal([]byte(tt.input), &b)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Unmarshal(%s) = %+v, want an error", tt.input, b)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", tt.input, err)
+			}
+			got, err := json.Marshal(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("round trip of %s = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	tehran := time.FixedZone("IRST", 3*3600+1800)
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{name: "nil", value: nil, want: ""},
+		{name: "time", value: time.Date(2024, 10, 6, 0, 0, 0, 0, time.UTC), want: "1403/07/15"},
+		// the day in the value's own location, not in UTC
+		{name: "time with zone", value: time.Date(2024, 10, 6, 1, 0, 0, 0, tehran), want: "1403/07/15"},
+		{name: "string", value: "2024-10-06", want: "1403/07/15"},
+		{name: "timestamp string", value: "2024-10-06 00:00:00+00", want: "1403/07/15"},
+		{name: "bytes", value: []byte("2024-10-06"), want: "1403/07/15"},
+		{name: "bad string", value: "06/10/2024", wantErr: true},
+		{name: "int", value: int64(20241006), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := mustNew(t, 1400, 1, 1)
+			err := d.Scan(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Scan(%v) = %s, want an error", tt.value, d)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v) error = %v", tt.value, err)
+			}
+			if d.String() != tt.want {
+				t.Errorf("Scan(%v) = %q, want %q", tt.value, d, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue(t *testing.T) {
+	value, err := Date{}.Value()
+	if err != nil || value != nil {
+		t.Errorf("zero Value() = %v, %v, want nil", value, err)
+	}
+
+	value, err = mustNew(t, 1403, 7, 15).Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 10, 6, 0, 0, 0, 0, time.UTC); value != want {
+		t.Errorf("Value() = %v, want %v", value, want)
+	}
+}
+
+func TestUnmarshalParam(t *testing.T) {
+	var d Date
+	if err := d.UnmarshalParam("1403/07/15"); err != nil || d.String() != "1403/07/15" {
+		t.Errorf("UnmarshalParam() = %s, %v", d, err)
+	}
+	if err := d.UnmarshalParam(""); err != nil || !d.IsZero() {
+		t.Errorf("UnmarshalParam(\"\") = %s, %v, want the zero date", d, err)
+	}
+}
@@ -0,0 +1,91 @@
+// Package lifecycle stops the parts of the service in order on shutdown:
+// the HTTP server first, then background jobs, the database and finally the
+// log sinks, so whatever the earlier steps log still gets out.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+type hook struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// Lifecycle runs its stop hooks in the reverse order they were registered,
+// like defers: what was set up first, and is needed by the rest, is
+// stopped last. The zero value is ready to use.
+type Lifecycle struct {
+	mu    sync.Mutex
+	hooks []hook
+}
+
+// OnStop registers stop to run on Stop.
+func (l *Lifecycle) OnStop(name string, stop func(ctx context.Context) error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.hooks = append(l.hooks, hook{name: name, stop: stop})
+}
+
+// Go runs job in its own goroutine until Stop cancels its context, and
+// lets Stop wait for it to return.
+func (l *Lifecycle) Go(name string, job func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		job(ctx)
+	}()
+	l.OnStop(name, func(stopCtx context.Context) error {
+		cancel()
+		select {
+		case <-done:
+			return nil
+		case <-stopCtx.Done():
+			return stopCtx.Err()
+		}
+	})
+}
+
+// Stop runs the hooks, newest first, and returns their errors joined. A
+// hook still running when ctx is done is left behind and reported; the
+// ones after it are still started, but no longer waited for.
+func (l *Lifecycle) Stop(ctx context.Context) error {
+	l.mu.Lock()
+	hooks := l.hooks
+	l.hooks = nil
+	l.mu.Unlock()
+
+	var errs []error
+	for i := len(hooks) - 1; i >= 0; i-- {
+		h := hooks[i]
+		start := time.Now()
+		if err := run(ctx, h); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+			log.Printf("shutdown: %s failed after %v: %v", h.name, time.Since(start).Round(time.Millisecond), err)
+			continue
+		}
+		log.Printf("shutdown: %s stopped in %v", h.name, time.Since(start).Round(time.Millisecond))
+	}
+	return errors.Join(errs...)
+}
+
+// run waits for the hook or ctx, whichever ends first, so hooks that cannot
+// be cancelled still cannot hold up the shutdown.
+func run(ctx context.Context, h hook) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- h.stop(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,162 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStop(t *testing.T) {
+	errFlush := errors.New("flush failed")
+	tests := []struct {
+		name string
+		// hooks in registration order: "ok", "fail" or "hang"
+		hooks   []string
+		timeout time.Duration
+		// the hooks that were started, in order
+		started []string
+		errs    []string
+		is      error
+	}{
+		{
+			name:    "none",
+			timeout: time.Second,
+		},
+		{
+			name:    "reverse order",
+			hooks:   []string{"ok", "ok", "ok"},
+			timeout: time.Second,
+			started: []string{"2", "1", "0"},
+		},
+		{
+			name:    "failure does not stop the rest",
+			hooks:   []string{"ok", "fail", "ok"},
+			timeout: time.Second,
+			started: []string{"2", "1", "0"},
+			errs:    []string{"1: flush failed"},
+			is:      errFlush,
+		},
+		{
+			name:    "deadline",
+			hooks:   []string{"hang", "hang", "ok"},
+			timeout: 20 * time.Millisecond,
+			started: []string{"2", "1", "0"},
+			errs:    []string{"1: context deadline exceeded", "0: context deadline exceeded"},
+			is:      context.DeadlineExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l Lifecycle
+			var mu sync.Mutex
+			var started []string
+			release := make(chan struct{})
+			defer close(release)
+			for i, kind := range tt.hooks {
+				name := string(rune('0' + i))
+				l.OnStop(name, func(ctx context.Context) error {
+					mu.Lock()
+					started = append(started, name)
+					mu.Unlock()
+					switch kind {
+					case "fail":
+						return errFlush
+					case "hang":
+						// ignores ctx, like a hook that cannot be cancelled
+						<-release
+					}
+					return nil
+				})
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+			start := time.Now()
+			err := l.Stop(ctx)
+			if elapsed := time.Since(start); elapsed > tt.timeout+100*time.Millisecond {
+				t.Errorf("Stop() took %v, want it bounded by %v", elapsed, tt.timeout)
+			}
+
+			var errs []string
+			if err != nil {
+				errs = strings.Split(err.Error(), "\n")
+			}
+			if !reflect.DeepEqual(errs, tt.errs) {
+				t.Errorf("Stop() errors = %q, want %q", errs, tt.errs)
+			}
+			if tt.is != nil && !errors.Is(err, tt.is) {
+				t.Errorf("Stop() = %v, want it to wrap %v", err, tt.is)
+			}
+
+			// hooks left behind by the deadline still get started
+			deadline := time.Now().Add(time.Second)
+			for {
+				mu.Lock()
+				got := append([]string(nil), started...)
+				mu.Unlock()
+				if len(got) == len(tt.started) || time.Now().After(deadline) {
+					if !reflect.DeepEqual(got, tt.started) {
+						t.Errorf("started %v, want %v", got, tt.started)
+					}
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestStopTwice(t *testing.T) {
+	var l Lifecycle
+	calls := 0
+	l.OnStop("server", func(context.Context) error {
+		calls++
+		return nil
+	})
+	for i := 0; i < 2; i++ {
+		if err := l.Stop(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("hook ran %d times, want once", calls)
+	}
+}
+
+func TestGo(t *testing.T) {
+	tests := []struct {
+		name string
+		// ignore makes the job keep running after its context is cancelled
+		ignore  bool
+		wantErr error
+	}{
+		{name: "stops on cancel"},
+		{name: "outlives the deadline", ignore: true, wantErr: context.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l Lifecycle
+			running := make(chan struct{})
+			release := make(chan struct{})
+			defer close(release)
+			l.Go("job", func(ctx context.Context) {
+				close(running)
+				<-ctx.Done()
+				if tt.ignore {
+					<-release
+				}
+			})
+			<-running
+
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			if err := l.Stop(ctx); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Stop() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package logging
+
+import (
+	"io"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Buffered queues entries for Sink and sends them from one goroutine. When
+// the queue is full Send waits up to BlockTimeout for room, then drops the
+// entry; a zero BlockTimeout drops at once so requests never wait on logs.
+type Buffered struct {
+	Name string
+
+	sink         Sink
+	entries      chan Entry
+	blockTimeout time.Duration
+	dropped      atomic.Int64
+	failed       atomic.Int64
+
+	// mu guards closed: Send registers in sending under it, and Close waits
+	// for those sends before run drains the queue, so no entry is queued
+	// after the last drain.
+	mu      sync.RWMutex
+	closed  bool
+	sending sync.WaitGroup
+
+	closing   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func NewBuffered(name string, sink Sink, size int, blockTimeout time.Duration) *Buffered {
+	b := &Buffered{
+		Name:         name,
+		sink:         sink,
+		entries:      make(chan Entry, size),
+		blockTimeout: blockTimeout,
+		closing:      make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	go b.run()
+	return b
+}
+
+// Send never returns an error; lost entries show up in Dropped instead.
+func (b *Buffered) Send(e Entry) error {
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		b.dropped.Add(1)
+		return nil
+	}
+	b.sending.Add(1)
+	b.mu.RUnlock()
+	defer b.sending.Done()
+
+	select {
+	case b.entries <- e:
+		return nil
+	default:
+	}
+	if b.blockTimeout > 0 {
+		timer := time.NewTimer(b.blockTimeout)
+		defer timer.Stop()
+		select {
+		case b.entries <- e:
+			return nil
+		case <-timer.C:
+		}
+	}
+	b.dropped.Add(1)
+	return nil
+}
+
+// Dropped counts the entries that did not fit in the buffer.
+func (b *Buffered) Dropped() int64 {
+	return b.dropped.Load()
+}
+
+// Failed counts the entries the sink returned an error for.
+func (b *Buffered) Failed() int64 {
+	return b.failed.Load()
+}
+
+// Close sends what is still queued, stops the goroutine and then closes the
+// sink when it is an io.Closer, so a Loki client pushes its last batch.
+// Entries sent after Close count as dropped.
+func (b *Buffered) Close() error {
+	var err error
+	b.closeOnce.Do(func() {
+		b.mu.Lock()
+		b.closed = true
+		b.mu.Unlock()
+		b.sending.Wait()
+		close(b.closing)
+		<-b.done
+		if closer, ok := b.sink.(io.Closer); ok {
+			err = closer.Close()
+		}
+	})
+	<-b.done
+	return err
+}
+
+// reportEvery is how often a sink that dropped entries says so in the
+// process log.
+const reportEvery = time.Minute
+
+func (b *Buffered) run() {
+	defer close(b.done)
+	ticker := time.NewTicker(reportEvery)
+	defer ticker.Stop()
+	var reported int64
+	for {
+		select {
+		case e := <-b.entries:
+			b.send(e)
+		case <-ticker.C:
+			if dropped := b.Dropped(); dropped > reported {
+				log.Printf("log sink %s: dropped %d entries in the last %v, buffer full (%d in total)", b.Name, dropped-reported, reportEvery, dropped)
+				reported = dropped
+			}
+		case <-b.closing:
+			for {
+				select {
+				case e := <-b.entries:
+					b.send(e)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (b *Buffered) send(e Entry) {
+	if err := b.sink.Send(e); err != nil {
+		// report the first failure and then every thousandth, not each one
+		if n := b.failed.Add(1); n == 1 || n%1000 == 0 {
+			log.Printf("log sink %s: %v (%d failed so far)", b.Name, err, n)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingSink struct {
+	sent   atomic.Int64
+	closed atomic.Bool
+}
+
+func (s *countingSink) Send(Entry) error {
+	s.sent.Add(1)
+	return nil
+}
+
+func (s *countingSink) Close() error {
+	s.closed.Store(true)
+	return nil
+}
+
+func TestBufferedCloseAccountsForEveryEntry(t *testing.T) {
+	tests := []struct {
+		name         string
+		size         int
+		blockTimeout time.Duration
+	}{
+		{"drop at once", 4, 0},
+		{"block for room", 4, time.Millisecond},
+		{"large buffer", 1024, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &countingSink{}
+			b := NewBuffered("test", sink, tt.size, tt.blockTimeout)
+
+			const senders, each = 8, 200
+			var wg sync.WaitGroup
+			for i := 0; i < senders; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < each; j++ {
+						b.Send(Entry{Line: "x"})
+					}
+				}()
+			}
+			time.Sleep(time.Millisecond)
+			if err := b.Close(); err != nil {
+				t.Fatal(err)
+			}
+			wg.Wait()
+
+			if got := sink.sent.Load() + b.Dropped(); got != senders*each {
+				t.Errorf("sent %d + dropped %d = %d, want %d", sink.sent.Load(), b.Dropped(), got, senders*each)
+			}
+			if !sink.closed.Load() {
+				t.Error("Close did not close the sink")
+			}
+		})
+	}
+}
+
+func TestBufferedSendAfterClose(t *testing.T) {
+	sink := &countingSink{}
+	b := NewBuffered("test", sink, 100, time.Second)
+	b.Close()
+	// the buffer has room, which must not matter once closed
+	for i := 0; i < 100; i++ {
+		b.Send(Entry{Line: "late"})
+	}
+	if sink.sent.Load() != 0 || b.Dropped() != 100 {
+		t.Errorf("sent %d, dropped %d, want 0 and 100", sink.sent.Load(), b.Dropped())
+	}
+}
+
+func TestFileClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	file, err := NewFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := NewBuffered("file", file, 4, 0)
+	b.Send(Entry{Line: "hello"})
+	if err := b.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Send(Entry{Line: "after close"}); err == nil {
+		t.Error("Send after Close wrote to the file, want it closed")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "hello\n" {
+		t.Errorf("file = %q, want %q", data, "hello\n")
+	}
+}
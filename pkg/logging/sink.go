@@ -0,0 +1,101 @@
+// Package logging ships structured log lines to pluggable sinks: stdout,
+// a file or Loki. Sinks are wrapped in a Buffered sink so a slow backend
+// never holds up a request; what does not fit in the buffer is dropped and
+// counted.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/grafana/loki-client-go/loki"
+	"github.com/prometheus/common/model"
+)
+
+// Entry is one log line. Labels are the Loki stream labels and must stay
+// low in cardinality; per-request values belong in Line.
+type Entry struct {
+	Labels map[string]string
+	Time   time.Time
+	Line   string
+}
+
+type Sink interface {
+	Send(Entry) error
+}
+
+// Writer writes the bare lines to W, one per line, as stdout and file sinks.
+type Writer struct {
+	mu   sync.Mutex
+	W    io.Writer
+	file *os.File
+}
+
+func NewStdout() *Writer {
+	return &Writer{W: os.Stdout}
+}
+
+// NewFile appends to the file at path, creating it when needed.
+func NewFile(path string) (*Writer, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("log file: %w", err)
+	}
+	return &Writer{W: file, file: file}, nil
+}
+
+func (w *Writer) Send(e Entry) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	_, err := io.WriteString(w.W, e.Line+"\n")
+	return err
+}
+
+// Close closes the file NewFile opened; stdout and other writers stay open.
+func (w *Writer) Close() error {
+	if w.file == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
+
+// Loki pushes entries as streams keyed by their labels.
+type Loki struct {
+	Client *loki.Client
+}
+
+func (l Loki) Send(e Entry) error {
+	labels := make(model.LabelSet, len(e.Labels))
+	for name, value := range e.Labels {
+		labels[model.LabelName(name)] = model.LabelValue(value)
+	}
+	return l.Client.Handle(labels, e.Time, e.Line)
+}
+
+// Close pushes the batch the client is still holding and stops it.
+func (l Loki) Close() error {
+	l.Client.Stop()
+	return nil
+}
+
+type multi []Sink
+
+// Multi sends every entry to all sinks and reports the first error.
+func Multi(sinks ...Sink) Sink {
+	return multi(sinks)
+}
+
+func (m multi) Send(e Entry) error {
+	var first error
+	for _, sink := range m {
+		if err := sink.Send(e); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
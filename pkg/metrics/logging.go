@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"ibrokers_service/pkg/logging"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterLogSinks exports the drop and failure counters of the log sinks.
+// Loki push failures of the client itself are in its promtail_* metrics.
+func RegisterLogSinks(sinks []*logging.Buffered) {
+	for _, sink := range sinks {
+		labels := prometheus.Labels{"sink": sink.Name}
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "log",
+			Name:        "entries_dropped_total",
+			Help:        "Log entries dropped because the sink buffer was full.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(sink.Dropped()) }))
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "log",
+			Name:        "entries_failed_total",
+			Help:        "Log entries the sink failed to write or push.",
+			ConstLabels: labels,
+		}, func() float64 { return float64(sink.Failed()) }))
+	}
+}
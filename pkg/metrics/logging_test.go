@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"errors"
+	"ibrokers_service/pkg/logging"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type failingSink struct{}
+
+func (failingSink) Send(logging.Entry) error {
+	return errors.New("down")
+}
+
+func TestRegisterLogSinks(t *testing.T) {
+	sink := logging.NewBuffered("failing", failingSink{}, 1, 0)
+	// the queue holds one entry, run may take some before it fills
+	for i := 0; i < 10; i++ {
+		sink.Send(logging.Entry{Line: "x"})
+	}
+	sink.Close()
+
+	registry := prometheus.NewRegistry()
+	defer func(registerer prometheus.Registerer) { prometheus.DefaultRegisterer = registerer }(prometheus.DefaultRegisterer)
+	prometheus.DefaultRegisterer = registry
+	RegisterLogSinks([]*logging.Buffered{sink})
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]float64{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "sink" && label.GetValue() == "failing" {
+					got[family.GetName()] = metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	tests := []struct {
+		name string
+		want int64
+	}{
+		{"ibrokers_log_entries_dropped_total", sink.Dropped()},
+		{"ibrokers_log_entries_failed_total", sink.Failed()},
+	}
+	for _, tt := range tests {
+		value, ok := got[tt.name]
+		if !ok {
+			t.Errorf("%s is not exported", tt.name)
+			continue
+		}
+		if value != float64(tt.want) {
+			t.Errorf("%s = %v, want %d", tt.name, value, tt.want)
+		}
+	}
+	if sink.Failed()+sink.Dropped() != 10 || sink.Failed() == 0 {
+		t.Errorf("failed %d, dropped %d, want 10 entries with some failed", sink.Failed(), sink.Dropped())
+	}
+}
@@ -0,0 +1,102 @@
+// Package metrics defines the Prometheus metrics of the service and serves
+// them, with the Go runtime and process collectors of the default registry,
+// on /metrics.
+package metrics
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gorm.io/gorm"
+)
+
+const namespace = "ibrokers"
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by route template, method and status code.",
+	}, []string{"method", "route", "status"})
+	httpDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by route template and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+	httpInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "HTTP requests being served.",
+	})
+
+	queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "GORM query latency by table, repository method and operation.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"table", "method", "operation"})
+	queryErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_errors_total",
+		Help:      "GORM queries that failed, not counting missing records.",
+	}, []string{"table", "method", "operation"})
+
+	uploadDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "minio",
+		Name:      "upload_duration_seconds",
+		Help:      "MinIO upload latency by bucket and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"bucket", "outcome"})
+	uploadSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "minio",
+		Name:      "upload_size_bytes",
+		Help:      "Size of the objects uploaded to MinIO by bucket.",
+		// 1KiB to 256MiB
+		Buckets: prometheus.ExponentialBuckets(1024, 4, 10),
+	}, []string{"bucket"})
+)
+
+// Handler serves the default registry, which also holds the Go runtime and
+// process collectors and the metrics of the Loki client.
+func Handler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
+
+// ObserveRequest records one served request; route is the route template so
+// IDs in paths do not multiply the series.
+func ObserveRequest(method, route string, status int, elapsed time.Duration) {
+	httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	httpDuration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+// ObserveUpload records one MinIO upload.
+func ObserveUpload(bucket string, size int64, elapsed time.Duration, err error) {
+	outcome := "ok"
+	if err != nil {
+		outcome = "error"
+	}
+	uploadDuration.WithLabelValues(bucket, outcome).Observe(elapsed.Seconds())
+	if err == nil {
+		uploadSize.WithLabelValues(bucket).Observe(float64(size))
+	}
+}
+
+func observeQuery(table, method, operation string, elapsed time.Duration, err error) {
+	queryDuration.WithLabelValues(table, method, operation).Observe(elapsed.Seconds())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		queryErrors.WithLabelValues(table, method, operation).Inc()
+	}
+}
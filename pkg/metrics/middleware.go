@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"ibrokers_service/pkg/middleware/logger"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware records the RED metrics of every request: rate and errors
+// through the status label of the request counter, duration through the
+// latency histogram.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		httpInFlight.Inc()
+		defer httpInFlight.Dec()
+		c.Next()
+		ObserveRequest(c.Request.Method, logger.Route(c), c.Writer.Status(), time.Since(start))
+	}
+}
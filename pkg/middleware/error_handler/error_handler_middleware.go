@@ -1,17 +1,108 @@
 package error_handler
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/auth"
+	"ibrokers_service/pkg/logging"
+	"ibrokers_service/pkg/middleware/logger"
+	"ibrokers_service/pkg/requestid"
+	"ibrokers_service/pkg/tracing"
+	"log"
+	"runtime/debug"
+	"time"
+
 	"github.com/gin-gonic/gin"
-	"net/http"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func ErrorHandlingMiddleware() gin.HandlerFunc {
+// incidentKey holds the incident ID on the panic event of the request span.
+const incidentKey = attribute.Key("incident_id")
+
+// incident is the error log entry of one recovered panic.
+type incident struct {
+	ID        string `json:"incident_id"`
+	RequestID string `json:"request_id,omitempty"`
+	TraceID   string `json:"trace_id,omitempty"`
+	Method    string `json:"method"`
+	Route     string `json:"route"`
+	Path      string `json:"path"`
+	Query     string `json:"query,omitempty"`
+	Filters   string `json:"filters,omitempty"`
+	User      string `json:"user,omitempty"`
+	ClientIP  string `json:"client_ip"`
+	Panic     string `json:"panic"`
+	Stack     string `json:"stack"`
+}
+
+// Recovery turns a panic anywhere further down the chain into a 500 problem
+// carrying an incident ID, and ships the stack with the request context to
+// the log sink, Loki in production, under that ID. Register it before every
+// middleware that could panic.
+func Recovery(sink logging.Sink) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
-			if err := recover(); err != nil {
-				c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal Server Error"})
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			entry := incident{
+				ID:        newIncidentID(),
+				RequestID: c.GetString(requestid.ContextKey),
+				TraceID:   tracing.TraceID(c.Request.Context()),
+				Method:    c.Request.Method,
+				Route:     logger.Route(c),
+				Path:      c.Request.URL.Path,
+				Query:     c.Request.URL.RawQuery,
+				User:      c.GetString(auth.UserIDKey),
+				ClientIP:  c.ClientIP(),
+				Panic:     fmt.Sprint(recovered),
+				Stack:     string(debug.Stack()),
+			}
+			if filters, ok := c.Get("filters"); ok {
+				entry.Filters = fmt.Sprintf("%+v", filters)
+			}
+			report(sink, entry)
+			span := trace.SpanFromContext(c.Request.Context())
+			span.RecordError(fmt.Errorf("panic: %v", recovered), trace.WithAttributes(incidentKey.String(entry.ID)))
+
+			if c.Writer.Written() {
+				// too late for a problem body, the status is already out
+				c.Abort()
+				return
 			}
+			err := apperror.ErrInternal.Wrap(fmt.Errorf("panic: %v", recovered)).WithIncident(entry.ID)
+			apperror.Write(c, err)
 		}()
 		c.Next()
 	}
 }
+
+func report(sink logging.Sink, entry incident) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("incident %s: %v", entry.ID, err)
+		return
+	}
+	_ = sink.Send(logging.Entry{
+		Labels: map[string]string{
+			"job":   logger.Job,
+			"level": "error",
+			"kind":  "panic",
+		},
+		Time: time.Now(),
+		Line: string(line),
+	})
+}
+
+func newIncidentID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%016x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
@@ -4,20 +4,42 @@ import (
 	"reflect"
 )
 
+// Field is a model field exposed through its `filter` struct tag.
+type Field struct {
+	// Name is the public filter name, FieldName the Go struct field.
+	Name      string
+	FieldName string
+	// Type has pointers removed.
+	Type reflect.Type
+}
+
 func GetAllowedFilters(model interface{}) []string {
-	val := reflect.TypeOf(model)
 	allowedFilters := []string{}
+	for _, field := range GetFilterFields(model) {
+		allowedFilters = append(allowedFilters, field.Name)
+	}
+	return allowedFilters
+}
+
+func GetFilterFields(model interface{}) []Field {
+	val := reflect.TypeOf(model)
+	fields := []Field{}
 
 	// بررسی نوع و فیلدها
 	if val.Kind() == reflect.Struct {
 		for i := 0; i < val.NumField(); i++ {
 			field := val.Field(i)
 			name := field.Tag.Get("filter")
-			if name != "" {
-				allowedFilters = append(allowedFilters, name)
+			if name == "" {
+				continue
 			}
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			fields = append(fields, Field{Name: name, FieldName: field.Name, Type: fieldType})
 		}
 	}
 
-	return allowedFilters
+	return fields
 }
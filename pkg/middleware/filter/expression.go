@@ -0,0 +1,128 @@
+package filter
+
+import (
+	"fmt"
+	"ibrokers_service/pkg/middleware/filter/operators"
+	"strings"
+)
+
+// ParseExpression parses a boolean filter expression such as
+//
+//	(tradingHallId:1|tradingHallId:2),initPrice__between:100,500
+//
+// A condition is key:value with the same keys and operator suffixes as plain
+// query parameters. "," is AND, "|" is OR and binds looser than ",", and
+// parentheses group. A comma only separates conditions when a new condition
+// or group follows it, so in and between keep their comma separated values.
+func (c *Mapper) ParseExpression(input string) (operators.FilterBlock, error) {
+	p := expressionParser{mapper: c, input: input}
+	block, err := p.or()
+	if err != nil {
+		return block, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return block, p.errorf("unexpected %q", p.input[p.pos])
+	}
+	return block, nil
+}
+
+type expressionParser struct {
+	mapper *Mapper
+	input  string
+	pos    int
+}
+
+func (p *expressionParser) or() (operators.FilterBlock, error) {
+	return p.list('|', operators.Or, p.and)
+}
+
+func (p *expressionParser) and() (operators.FilterBlock, error) {
+	return p.list(',', operators.And, p.term)
+}
+
+func (p *expressionParser) list(separator byte, logic string, next func() (operators.FilterBlock, error)) (operators.FilterBlock, error) {
+	var blocks []operators.FilterBlock
+	for {
+		block, err := next()
+		if err != nil {
+			return block, err
+		}
+		blocks = append(blocks, block)
+
+		p.skipSpaces()
+		if p.pos >= len(p.input) || p.input[p.pos] != separator {
+			return operators.Group(logic, blocks), nil
+		}
+		p.pos++
+	}
+}
+
+func (p *expressionParser) term() (operators.FilterBlock, error) {
+	p.skipSpaces()
+	if p.pos < len(p.input) && p.input[p.pos] == '(' {
+		p.pos++
+		block, err := p.or()
+		if err != nil {
+			return block, err
+		}
+		p.skipSpaces()
+		if p.pos >= len(p.input) || p.input[p.pos] != ')' {
+			return block, p.errorf("missing )")
+		}
+		p.pos++
+		return block, nil
+	}
+
+	key := p.key(p.pos)
+	if key == "" || p.pos+len(key) >= len(p.input) || p.input[p.pos+len(key)] != ':' {
+		return operators.FilterBlock{}, p.errorf("expected key:value")
+	}
+	p.pos += len(key) + 1
+
+	start := p.pos
+	for p.pos < len(p.input) {
+		ch := p.input[p.pos]
+		if ch == '|' || ch == ')' || (ch == ',' && p.startsTerm(p.pos+1)) {
+			break
+		}
+		p.pos++
+	}
+
+	_key, operator := p.mapper.SplitCommand(key)
+	return operators.FilterBlock{Key: _key, Operator: operator, Value: strings.TrimSpace(p.input[start:p.pos])}, nil
+}
+
+// startsTerm reports whether a condition or a group begins at i.
+func (p *expressionParser) startsTerm(i int) bool {
+	for i < len(p.input) && p.input[i] == ' ' {
+		i++
+	}
+	if i < len(p.input) && p.input[i] == '(' {
+		return true
+	}
+	key := p.key(i)
+	return key != "" && i+len(key) < len(p.input) && p.input[i+len(key)] == ':'
+}
+
+func (p *expressionParser) key(i int) string {
+	end := i
+	for end < len(p.input) && isKeyChar(p.input[end]) {
+		end++
+	}
+	return p.input[i:end]
+}
+
+func (p *expressionParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *expressionParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s at position %d of %s", ErrInvalidFilter, fmt.Sprintf(format, args...), p.pos, ExpressionParam)
+}
+
+func isKeyChar(ch byte) bool {
+	return ch == '_' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9'
+}
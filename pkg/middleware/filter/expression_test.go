@@ -0,0 +1,106 @@
+package filter
+
+import (
+	"errors"
+	"ibrokers_service/pkg/middleware/filter/operators"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func cond(key, operator, value string) operators.FilterBlock {
+	return operators.FilterBlock{Key: key, Operator: operator, Value: value}
+}
+
+func and(blocks ...operators.FilterBlock) operators.FilterBlock {
+	return operators.FilterBlock{Logic: operators.And, Children: blocks}
+}
+
+func or(blocks ...operators.FilterBlock) operators.FilterBlock {
+	return operators.FilterBlock{Logic: operators.Or, Children: blocks}
+}
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  operators.FilterBlock
+	}{
+		{"condition", "tradingHallId:1", cond("tradingHallId", operators.Exact, "1")},
+		{"operator suffix", "initPrice__gte:100", cond("initPrice", operators.Gte, "100")},
+		{"underscores in key", "offer_date__lt:1403-01-01", cond("offer_date", operators.Lt, "1403-01-01")},
+		{"and", "a:1,b:2", and(cond("a", operators.Exact, "1"), cond("b", operators.Exact, "2"))},
+		{"or", "a:1|a:2", or(cond("a", operators.Exact, "1"), cond("a", operators.Exact, "2"))},
+		{
+			"or binds looser than and",
+			"a:1,b:2|c:3",
+			or(and(cond("a", operators.Exact, "1"), cond("b", operators.Exact, "2")), cond("c", operators.Exact, "3")),
+		},
+		{
+			"parentheses group",
+			"a:1,(b:2|c:3)",
+			and(cond("a", operators.Exact, "1"), or(cond("b", operators.Exact, "2"), cond("c", operators.Exact, "3"))),
+		},
+		{
+			"nested groups",
+			"((a:1|b:2),c:3)|d:4",
+			or(and(or(cond("a", operators.Exact, "1"), cond("b", operators.Exact, "2")), cond("c", operators.Exact, "3")), cond("d", operators.Exact, "4")),
+		},
+		{
+			"list values keep their commas",
+			"(tradingHallId:1|tradingHallId:2),initPrice__between:100,500",
+			and(or(cond("tradingHallId", operators.Exact, "1"), cond("tradingHallId", operators.Exact, "2")), cond("initPrice", operators.Between, "100,500")),
+		},
+		{
+			"list value before a condition",
+			"id__in:1,2,3,name:x",
+			and(cond("id", operators.In, "1,2,3"), cond("name", operators.Exact, "x")),
+		},
+		{
+			"spaces",
+			" a:1 , ( b:2 | c:3 ) ",
+			and(cond("a", operators.Exact, "1"), or(cond("b", operators.Exact, "2"), cond("c", operators.Exact, "3"))),
+		},
+		{"empty value", "name:", cond("name", operators.Exact, "")},
+		{"value with colon", "time:12:30", cond("time", operators.Exact, "12:30")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := Mapper{}
+			got, err := mapper.ParseExpression(tt.input)
+			if err != nil {
+				t.Fatalf("ParseExpression(%q) error = %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseExpression(%q)\n got %+v\nwant %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"empty", "", "expected key:value at position 0"},
+		{"no colon", "a", "expected key:value at position 0"},
+		{"missing parenthesis", "(a:1|b:2", "missing ) at position 8"},
+		{"stray parenthesis", "a:1)", `unexpected ')' at position 3`},
+		{"dangling or", "a:1|", "expected key:value at position 4"},
+		{"empty group", "()", "expected key:value at position 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapper := Mapper{}
+			_, err := mapper.ParseExpression(tt.input)
+			if !errors.Is(err, ErrInvalidFilter) {
+				t.Fatalf("ParseExpression(%q) error = %v, want ErrInvalidFilter", tt.input, err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseExpression(%q) error = %q, want it to contain %q", tt.input, err, tt.want)
+			}
+		})
+	}
+}
@@ -2,58 +2,53 @@ package filter
 
 import (
 	"ibrokers_service/pkg/middleware/filter/operators"
+	"net/url"
 	"strings"
 )
 
+// ExpressionParam is the query parameter holding a boolean filter
+// expression, see ParseExpression.
+const ExpressionParam = "filter"
+
 type Mapper struct {
-	Operators operators.Operators
 }
 
-func (c *Mapper) Convert(filters []string) []operators.FilterBlock {
+// Convert turns query parameters into filter blocks, which are ANDed. A key
+// given several times matches any of its values.
+func (c *Mapper) Convert(query url.Values) ([]operators.FilterBlock, error) {
 	var _operators []operators.FilterBlock
-	for _, element := range filters {
-		if strings.Contains(element, "=") {
-			query := strings.Split(element, "=")
-			if len(query) == 2 {
-				_key, operator, isMulti := c.SplitCommand(query[0])
-				if isMulti {
-					operator := c.CheckOperator(operator)
-					_operators = append(_operators, operators.FilterBlock{Key: _key, Operator: operator, Value: query[1]})
-				} else {
-					_operators = append(_operators, operators.FilterBlock{Key: query[0], Operator: c.Operators.Equal(), Value: query[1]})
+	for key, values := range query {
+		if key == ExpressionParam {
+			for _, value := range values {
+				block, err := c.ParseExpression(value)
+				if err != nil {
+					return nil, err
 				}
+				// wrapped so its unknown keys are rejected, not skipped
+				_operators = append(_operators, operators.FilterBlock{Logic: operators.And, Children: []operators.FilterBlock{block}})
 			}
+			continue
 		}
-	}
-
-	return _operators
-}
 
-func (c *Mapper) SplitCommand(key string) (_key string, operator string, isMulti bool) {
-	if strings.Contains(key, "_") {
-		parts := strings.Split(key, "_")
-		_key = parts[0]
-		operator = parts[1]
-		isMulti = true
-	} else {
-		_key = key
-		operator = ""
-		isMulti = false
+		_key, operator := c.SplitCommand(key)
+		var alternatives []operators.FilterBlock
+		for _, value := range values {
+			alternatives = append(alternatives, operators.FilterBlock{Key: _key, Operator: operator, Value: value})
+		}
+		if len(alternatives) > 0 {
+			_operators = append(_operators, operators.Group(operators.Or, alternatives))
+		}
 	}
-	return _key, operator, isMulti
+	return _operators, nil
 }
 
-func (c *Mapper) CheckOperator(operator string) string {
-	switch operator {
-	case "gt":
-		return c.Operators.GreaterThan()
-	case "lt":
-		return c.Operators.LessThan()
-	case "lte":
-		return c.Operators.LessThanEqual()
-	case "gte":
-		return c.Operators.GreaterThanEqual()
-	default:
-		return c.Operators.Equal()
+// SplitCommand splits a key on its last "__", so column names that contain
+// underscores are kept whole. Keys without a suffix are exact matches; an
+// unknown suffix is returned as is and rejected when the filters are built.
+func (c *Mapper) SplitCommand(key string) (_key string, operator string) {
+	i := strings.LastIndex(key, "__")
+	if i <= 0 {
+		return key, operators.Exact
 	}
+	return key[:i], key[i+2:]
 }
@@ -0,0 +1,67 @@
+package filter
+
+import (
+	"ibrokers_service/pkg/middleware/filter/operators"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []operators.FilterBlock
+	}{
+		{"none", "", nil},
+		{"condition", "initPrice__gte=100", []operators.FilterBlock{cond("initPrice", operators.Gte, "100")}},
+		{
+			"repeated key is ored",
+			"tradingHallId=1&tradingHallId=2",
+			[]operators.FilterBlock{or(cond("tradingHallId", operators.Exact, "1"), cond("tradingHallId", operators.Exact, "2"))},
+		},
+		{
+			// wrapped so its keys are checked like those of any group
+			"expression",
+			"filter=a:1|b:2",
+			[]operators.FilterBlock{and(or(cond("a", operators.Exact, "1"), cond("b", operators.Exact, "2")))},
+		},
+		{"single condition expression", "filter=a:1", []operators.FilterBlock{and(cond("a", operators.Exact, "1"))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mapper := Mapper{}
+			got, err := mapper.Convert(query)
+			if err != nil {
+				t.Fatalf("Convert(%q) error = %v", tt.query, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Convert(%q)\n got %+v\nwant %+v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitCommand(t *testing.T) {
+	tests := []struct {
+		key, wantKey, wantOperator string
+	}{
+		{"price", "price", operators.Exact},
+		{"price__gte", "price", operators.Gte},
+		{"offer_date__lt", "offer_date", operators.Lt},
+		{"a__b__in", "a__b", operators.In},
+		{"__gte", "__gte", operators.Exact},
+		{"price__near", "price", "near"},
+	}
+	for _, tt := range tests {
+		mapper := Mapper{}
+		key, operator := mapper.SplitCommand(tt.key)
+		if key != tt.wantKey || operator != tt.wantOperator {
+			t.Errorf("SplitCommand(%q) = %q, %q, want %q, %q", tt.key, key, operator, tt.wantKey, tt.wantOperator)
+		}
+	}
+}
@@ -1,7 +1,7 @@
 package filter
 
 import (
-	"fmt"
+	"ibrokers_service/pkg/apperror"
 	"ibrokers_service/pkg/middleware/filter/operators"
 	"net/http"
 
@@ -12,13 +12,12 @@ func QueryFilterMiddleware(filterMapper Mapper) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var blocks []operators.FilterBlock
 		if c.Request.Method == http.MethodGet {
-			var filters []string
-			for key, values := range c.Request.URL.Query() {
-				if len(values) > 0 {
-					filters = append(filters, fmt.Sprintf("%s=%s", key, values[0]))
-				}
+			var err error
+			blocks, err = filterMapper.Convert(c.Request.URL.Query())
+			if err != nil {
+				apperror.Write(c, Invalid(err))
+				return
 			}
-			blocks = filterMapper.Convert(filters)
 		}
 		c.Set("filters", blocks)
 		c.Next()
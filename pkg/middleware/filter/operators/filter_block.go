@@ -1,14 +1,32 @@
 package operators
 
-import "fmt"
+// Logic joins the children of a group.
+const (
+	And = "and"
+	Or  = "or"
+)
 
+// FilterBlock is one parsed condition: Key is the public filter name,
+// Operator one of Names and Value the raw, not yet typed, value. A group
+// sets Logic and Children instead and matches when all (And) or any (Or)
+// of its children match.
 type FilterBlock struct {
 	Key      string
 	Operator string
 	Value    string
+
+	Logic    string
+	Children []FilterBlock
+}
+
+func (c FilterBlock) IsGroup() bool {
+	return c.Logic != ""
 }
 
-func (c *FilterBlock) Command() string {
-	command := fmt.Sprintf("%s%s%s", c.Key, c.Operator, c.Value)
-	return command
+// Group joins blocks with logic, returning a single block unchanged.
+func Group(logic string, blocks []FilterBlock) FilterBlock {
+	if len(blocks) == 1 {
+		return blocks[0]
+	}
+	return FilterBlock{Logic: logic, Children: blocks}
 }
@@ -1,24 +1,85 @@
 package operators
 
+import (
+	"strings"
+
+	"gorm.io/gorm/clause"
+)
+
 type GormOperator struct {
 }
 
-func (c GormOperator) Equal() string {
-	return "="
+func (c GormOperator) Equal(column clause.Column, value interface{}) clause.Expression {
+	return clause.Eq{Column: column, Value: value}
+}
+
+func (c GormOperator) NotEqual(column clause.Column, value interface{}) clause.Expression {
+	return clause.Neq{Column: column, Value: value}
+}
+
+func (c GormOperator) LessThan(column clause.Column, value interface{}) clause.Expression {
+	return clause.Lt{Column: column, Value: value}
+}
+
+func (c GormOperator) GreaterThan(column clause.Column, value interface{}) clause.Expression {
+	return clause.Gt{Column: column, Value: value}
+}
+
+func (c GormOperator) LessThanEqual(column clause.Column, value interface{}) clause.Expression {
+	return clause.Lte{Column: column, Value: value}
+}
+
+func (c GormOperator) GreaterThanEqual(column clause.Column, value interface{}) clause.Expression {
+	return clause.Gte{Column: column, Value: value}
+}
+
+func (c GormOperator) In(column clause.Column, values []interface{}) clause.Expression {
+	return clause.IN{Column: column, Values: values}
+}
+
+func (c GormOperator) Contains(column clause.Column, value string) clause.Expression {
+	return clause.Like{Column: column, Value: "%" + escapeLike(value) + "%"}
+}
+
+// IContains lower cases both sides and maps the Arabic ي and ك in the column
+// onto the Persian ی and ک, so "كالا" finds "کالا" and the other way around.
+func (c GormOperator) IContains(column clause.Column, value string) clause.Expression {
+	return clause.Expr{
+		SQL:  "REPLACE(REPLACE(LOWER(?), ?, ?), ?, ?) LIKE ?",
+		Vars: []interface{}{column, "ي", "ی", "ك", "ک", "%" + escapeLike(NormalizePersian(value)) + "%"},
+	}
+}
+
+func (c GormOperator) StartsWith(column clause.Column, value string) clause.Expression {
+	return clause.Like{Column: column, Value: escapeLike(value) + "%"}
+}
+
+func (c GormOperator) IsNull(column clause.Column, isNull bool) clause.Expression {
+	if isNull {
+		return clause.Eq{Column: column, Value: nil}
+	}
+	return clause.Neq{Column: column, Value: nil}
+}
+
+func (c GormOperator) Between(column clause.Column, from, to interface{}) clause.Expression {
+	return clause.Expr{SQL: "? BETWEEN ? AND ?", Vars: []interface{}{column, from, to}}
 }
 
-func (c GormOperator) LessThan() string {
-	return "<"
+func (c GormOperator) And(expressions ...clause.Expression) clause.Expression {
+	return clause.And(expressions...)
 }
 
-func (c GormOperator) GreaterThan() string {
-	return ">"
+func (c GormOperator) Or(expressions ...clause.Expression) clause.Expression {
+	return clause.Or(expressions...)
 }
 
-func (c GormOperator) LessThanEqual() string {
-	return "<="
+// NormalizePersian lower cases value and replaces the Arabic ye and kaf with
+// their Persian forms, matching what IContains does to the column.
+func NormalizePersian(value string) string {
+	return strings.NewReplacer("ي", "ی", "ك", "ک").Replace(strings.ToLower(value))
 }
 
-func (c GormOperator) GreaterThanEqual() string {
-	return ">="
+// escapeLike makes the LIKE wildcards in user input match literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
 }
@@ -1,9 +1,51 @@
 package operators
 
+import "gorm.io/gorm/clause"
+
+// Operator names as they appear after the last "__" of a filter key, e.g.
+// offerDate__gte. A key without a suffix is an Exact match.
+const (
+	Exact      = "exact"
+	Ne         = "ne"
+	Gt         = "gt"
+	Gte        = "gte"
+	Lt         = "lt"
+	Lte        = "lte"
+	In         = "in"
+	Contains   = "contains"
+	IContains  = "icontains"
+	StartsWith = "startswith"
+	IsNull     = "isnull"
+	Between    = "between"
+)
+
+var Names = []string{Exact, Ne, Gt, Gte, Lt, Lte, In, Contains, IContains, StartsWith, IsNull, Between}
+
+// Operators renders a filter on an already whitelisted column. Values are
+// always bound as parameters, never formatted into the SQL.
 type Operators interface {
-	Equal() string
-	LessThan() string
-	GreaterThan() string
-	LessThanEqual() string
-	GreaterThanEqual() string
+	Equal(column clause.Column, value interface{}) clause.Expression
+	NotEqual(column clause.Column, value interface{}) clause.Expression
+	LessThan(column clause.Column, value interface{}) clause.Expression
+	GreaterThan(column clause.Column, value interface{}) clause.Expression
+	LessThanEqual(column clause.Column, value interface{}) clause.Expression
+	GreaterThanEqual(column clause.Column, value interface{}) clause.Expression
+	In(column clause.Column, values []interface{}) clause.Expression
+	Contains(column clause.Column, value string) clause.Expression
+	// IContains ignores case and the Arabic/Persian variants of ye and kaf.
+	IContains(column clause.Column, value string) clause.Expression
+	StartsWith(column clause.Column, value string) clause.Expression
+	IsNull(column clause.Column, isNull bool) clause.Expression
+	Between(column clause.Column, from, to interface{}) clause.Expression
+	And(expressions ...clause.Expression) clause.Expression
+	Or(expressions ...clause.Expression) clause.Expression
+}
+
+func IsOperator(name string) bool {
+	for _, known := range Names {
+		if name == known {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,116 @@
+package filter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"ibrokers_service/pkg/middleware/filter/operators"
+	"strings"
+)
+
+// SearchRequest is the body of the search endpoints. It carries the same
+// conditions as the query string, as a tree:
+//
+//	{"filter": {"logic": "and", "filters": [
+//	    {"logic": "or", "filters": [
+//	        {"key": "tradingHallId", "value": 1},
+//	        {"key": "tradingHallId", "value": 2}]},
+//	    {"key": "initPrice", "operator": "between", "value": [100, 500]}]}}
+type SearchRequest struct {
+	Filter *Node `json:"filter"`
+}
+
+// Node is either a group, with Logic and Filters, or a condition. The key of
+// a condition may carry the operator suffix instead of Operator, and its
+// value is a number, string or array as the operator takes it.
+type Node struct {
+	Logic    string          `json:"logic"`
+	Filters  []Node          `json:"filters"`
+	Key      string          `json:"key"`
+	Operator string          `json:"operator"`
+	Value    json.RawMessage `json:"value" swaggertype:"object"`
+}
+
+// Blocks converts the request into filter blocks for the query builder.
+func (c *Mapper) Blocks(request SearchRequest) ([]operators.FilterBlock, error) {
+	if request.Filter == nil {
+		return nil, nil
+	}
+	block, err := c.node(*request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	return []operators.FilterBlock{{Logic: operators.And, Children: []operators.FilterBlock{block}}}, nil
+}
+
+func (c *Mapper) node(node Node) (operators.FilterBlock, error) {
+	if node.Key == "" {
+		logic := strings.ToLower(node.Logic)
+		if logic == "" {
+			logic = operators.And
+		}
+		if logic != operators.And && logic != operators.Or {
+			return operators.FilterBlock{}, fmt.Errorf("%w: logic must be and or or, got %q", ErrInvalidFilter, node.Logic)
+		}
+		if len(node.Filters) == 0 {
+			return operators.FilterBlock{}, fmt.Errorf("%w: a group needs filters and a condition needs a key", ErrInvalidFilter)
+		}
+		children := make([]operators.FilterBlock, len(node.Filters))
+		for i, child := range node.Filters {
+			block, err := c.node(child)
+			if err != nil {
+				return block, err
+			}
+			children[i] = block
+		}
+		return operators.Group(logic, children), nil
+	}
+
+	key, operator := node.Key, node.Operator
+	if operator == "" {
+		key, operator = c.SplitCommand(key)
+	}
+	value, err := rawValue(node.Value)
+	if err != nil {
+		return operators.FilterBlock{}, fmt.Errorf("%w: %s: %s", ErrInvalidFilter, node.Key, err)
+	}
+	return operators.FilterBlock{Key: key, Operator: operator, Value: value}, nil
+}
+
+// rawValue turns a JSON scalar or array into the text form used in query
+// strings, arrays becoming comma separated lists.
+func rawValue(raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return "", err
+		}
+		values := make([]string, len(items))
+		for i, item := range items {
+			value, err := scalar(item)
+			if err != nil {
+				return "", err
+			}
+			values[i] = value
+		}
+		return strings.Join(values, ","), nil
+	}
+	return scalar(raw)
+}
+
+func scalar(raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	switch {
+	case len(raw) == 0:
+		return "", fmt.Errorf("missing value")
+	case raw[0] == '"':
+		var value string
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	case raw[0] == '[' || raw[0] == '{':
+		return "", fmt.Errorf("value must be a string, number, boolean or a list of them")
+	default:
+		return string(raw), nil
+	}
+}
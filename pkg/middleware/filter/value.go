@@ -0,0 +1,133 @@
+package filter
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/middleware/filter/operators"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidFilter is wrapped by every error returned for a filter that
+// cannot be applied, so callers can answer it with 400.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Error describes a rejected filter and lists the filters that are valid on
+// the model.
+type Error struct {
+	Key     string
+	Reason  string
+	Allowed []string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid filter %s: %s; valid filters are: %s", e.Key, e.Reason, strings.Join(e.Allowed, ", "))
+}
+
+func (e *Error) Unwrap() error {
+	return ErrInvalidFilter
+}
+
+// Invalid converts a filter error into the validation error sent to the
+// client, naming the rejected filter when err is an *Error.
+func Invalid(err error) *apperror.Error {
+	var filterErr *Error
+	if !errors.As(err, &filterErr) {
+		return apperror.ErrInvalidFilter.WithDetail("%s", err)
+	}
+	return apperror.ErrInvalidFilter.WithDetail("%s", err).WithFields(apperror.Field{
+		Name: filterErr.Key,
+		Code: apperror.ErrInvalidFilter.Code,
+		Message: apperror.Message{
+			En: filterErr.Reason,
+			Fa: "این فیلتر روی این فیلد قابل اعمال نیست.",
+		},
+	})
+}
+
+var textUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// TypeName is the name of a filter type as shown to API clients.
+func TypeName(t reflect.Type) string {
+	switch {
+	case t == reflect.TypeOf(time.Time{}):
+		return "datetime"
+	case reflect.PointerTo(t).Implements(textUnmarshaler):
+		return strings.ToLower(t.Name())
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Bool:
+		return "bool"
+	default:
+		return "string"
+	}
+}
+
+// Supports reports whether operator can be applied to a field of type t.
+func Supports(t reflect.Type, operator string) bool {
+	switch operator {
+	case operators.Exact, operators.Ne, operators.In, operators.IsNull:
+		return true
+	case operators.Contains, operators.IContains, operators.StartsWith:
+		return t.Kind() == reflect.String
+	case operators.Gt, operators.Gte, operators.Lt, operators.Lte, operators.Between:
+		return t.Kind() != reflect.Bool
+	default:
+		return false
+	}
+}
+
+// ParseValue converts a raw query value into a value of type t. Types that
+// implement encoding.TextUnmarshaler, such as jdate.Date and time.Time,
+// parse themselves.
+func ParseValue(t reflect.Type, raw string) (interface{}, error) {
+	raw = strings.TrimSpace(raw)
+	value := reflect.New(t)
+	if unmarshaler, ok := value.Interface().(encoding.TextUnmarshaler); ok {
+		if err := unmarshaler.UnmarshalText([]byte(raw)); err != nil {
+			return nil, err
+		}
+		return value.Elem().Interface(), nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		value.Elem().SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, t.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an integer", raw)
+		}
+		value.Elem().SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, t.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a positive integer", raw)
+		}
+		value.Elem().SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, t.Bits())
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", raw)
+		}
+		value.Elem().SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", raw)
+		}
+		value.Elem().SetBool(b)
+	default:
+		return nil, fmt.Errorf("fields of type %s cannot be filtered", t)
+	}
+	return value.Elem().Interface(), nil
+}
@@ -1,60 +1,101 @@
 package logger
 
 import (
+	"encoding/json"
 	"fmt"
+	"ibrokers_service/pkg/logging"
+	"ibrokers_service/pkg/requestid"
+	"ibrokers_service/pkg/tracing"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/grafana/loki-client-go/loki"
-	"github.com/prometheus/common/model"
 )
 
-func Logger(lokiClient *loki.Client) gin.HandlerFunc {
+// Job is the job label of every stream the service writes.
+const Job = "gin-server"
+
+// accessLog is the JSON line written for every request.
+type accessLog struct {
+	Time      string   `json:"time"`
+	Level     string   `json:"level"`
+	RequestID string   `json:"request_id"`
+	TraceID   string   `json:"trace_id,omitempty"`
+	Method    string   `json:"method"`
+	Route     string   `json:"route"`
+	Path      string   `json:"path"`
+	Status    int      `json:"status"`
+	LatencyMs float64  `json:"latency_ms"`
+	ClientIP  string   `json:"client_ip"`
+	UserAgent string   `json:"user_agent"`
+	Bytes     int      `json:"bytes"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// Logger writes one JSON line per request to sink. Only the method, the
+// route template and the status class become labels; everything that
+// differs per request, like the path with its IDs, the client IP and the
+// latency, stays in the line so Loki keeps a handful of streams.
+func Logger(sink logging.Sink) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		startTime := time.Now()
+		start := time.Now()
 		c.Next()
-		endTime := time.Now()
-		latency := endTime.Sub(startTime)
-		statusCode := c.Writer.Status()
-
-		log.Printf("| %3d | %13v | %-7s %s\n",
-			statusCode,
-			latency,
-			c.Request.Method,
-			c.Request.URL.Path,
-		)
-
-		clientIP := c.ClientIP()
-		userAgent := c.Request.UserAgent()
-
-		var deviceType string
-		if strings.Contains(strings.ToLower(userAgent), "mobile") {
-			deviceType = "mobile"
-		} else {
-			deviceType = "desktop"
-		}
+		latency := time.Since(start)
+		status := c.Writer.Status()
 
-		labels := model.LabelSet{
-			"job":     "gin-server",
-			"status":  model.LabelValue(fmt.Sprintf("%d", statusCode)),
-			"method":  model.LabelValue(c.Request.Method),
-			"path":    model.LabelValue(c.Request.URL.Path),
-			"ip":      model.LabelValue(clientIP),
-			"device":  model.LabelValue(deviceType),
-			"latency": model.LabelValue(latency.String()),
+		entry := accessLog{
+			Time:      start.Format(time.RFC3339Nano),
+			Level:     level(status),
+			RequestID: c.GetString(requestid.ContextKey),
+			TraceID:   tracing.TraceID(c.Request.Context()),
+			Method:    c.Request.Method,
+			Route:     Route(c),
+			Path:      c.Request.URL.Path,
+			Status:    status,
+			LatencyMs: float64(latency.Microseconds()) / 1000,
+			ClientIP:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			Bytes:     max(c.Writer.Size(), 0),
+		}
+		for _, err := range c.Errors {
+			entry.Errors = append(entry.Errors, err.Error())
 		}
 
-		// ایجاد لاگ برای ارسال به Loki
-		line := fmt.Sprintf("| %3d | %13v | %-7s %s", statusCode, latency, c.Request.Method, c.Request.URL.Path)
-
-		// Send the log to Loki using Push method
-		err := lokiClient.Handle(labels, time.Now(), line)
+		line, err := json.Marshal(entry)
 		if err != nil {
-			log.Printf("Error sending log to Loki: %v", err)
-		} else {
-			log.Printf("Log successfully sent to Loki")
+			log.Printf("access log: %v", err)
+			return
 		}
+		_ = sink.Send(logging.Entry{
+			Labels: map[string]string{
+				"job":          Job,
+				"method":       entry.Method,
+				"route":        entry.Route,
+				"status_class": fmt.Sprintf("%dxx", status/100),
+			},
+			Time: start,
+			Line: string(line),
+		})
+	}
+}
+
+// Route is the route template of the request, like /offer/api/v1/:id/, or
+// "unmatched" for requests no route took, whose raw paths would make
+// unbounded label values.
+func Route(c *gin.Context) string {
+	if route := c.FullPath(); route != "" {
+		return route
+	}
+	return "unmatched"
+}
+
+func level(status int) string {
+	switch {
+	case status >= 500:
+		return "error"
+	case status >= 400:
+		return "warn"
+	default:
+		return "info"
 	}
 }
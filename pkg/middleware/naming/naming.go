@@ -0,0 +1,105 @@
+package naming
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Legacy re-keys response bodies with the Go field names the API used
+// before the JSON tags, such as "Persianname" for "persianName", for clients
+// that still read those. Values keep their own JSON encoding; only the keys
+// of structs change, in field order, and omitempty is honored as before.
+func Legacy(v any) any {
+	return legacy(reflect.ValueOf(v))
+}
+
+var (
+	marshalerType     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func legacy(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Type().Implements(marshalerType) || v.Type().Implements(textMarshalerType) {
+		return v.Interface()
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return legacy(v.Elem())
+	case reflect.Struct:
+		return legacyStruct(v)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = legacy(v.Index(i))
+		}
+		return items
+	default:
+		return v.Interface()
+	}
+}
+
+func legacyStruct(v reflect.Value) object {
+	t := v.Type()
+	out := make(object, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && options == "" {
+			continue
+		}
+		value := v.Field(i)
+		if strings.Contains(options, "omitempty") && value.IsZero() && isEmptyKind(value.Kind()) {
+			continue
+		}
+		out = append(out, member{Key: field.Name, Value: legacy(value)})
+	}
+	return out
+}
+
+// isEmptyKind reports the kinds whose zero value encoding/json omits.
+func isEmptyKind(kind reflect.Kind) bool {
+	return kind != reflect.Struct && kind != reflect.Array
+}
+
+type member struct {
+	Key   string
+	Value any
+}
+
+// object is a JSON object that keeps the order of its members.
+type object []member
+
+func (o object) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, m := range o {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		key, err := json.Marshal(m.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, key...)
+		buf = append(buf, ':')
+		buf = append(buf, value...)
+	}
+	return append(buf, '}'), nil
+}
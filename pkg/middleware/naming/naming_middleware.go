@@ -0,0 +1,28 @@
+package naming
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Header lets a client pick the field names of one request, "legacy" or
+// "camel", whatever the service default is.
+const Header = "X-Field-Names"
+
+// Middleware sets the "legacy_names" context key the handlers read before
+// rendering a resource. legacyDefault is used when the request does not
+// send Header.
+func Middleware(legacyDefault bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		legacy := legacyDefault
+		switch strings.ToLower(c.GetHeader(Header)) {
+		case "legacy":
+			legacy = true
+		case "camel":
+			legacy = false
+		}
+		c.Set("legacy_names", legacy)
+		c.Next()
+	}
+}
@@ -0,0 +1,51 @@
+package ordering
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+// ErrInvalidOrdering is wrapped by the error returned for an ordering on a
+// field that is not sortable.
+var ErrInvalidOrdering = errors.New("invalid ordering")
+
+// OrderBlock is one entry of ?ordering=, e.g. -offerDate.
+type OrderBlock struct {
+	Key  string
+	Desc bool
+}
+
+// Parse splits a comma separated ordering list; a leading "-" sorts
+// descending.
+func Parse(value string) []OrderBlock {
+	var blocks []OrderBlock
+	for _, key := range strings.Split(value, ",") {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		desc := strings.HasPrefix(key, "-")
+		blocks = append(blocks, OrderBlock{Key: strings.TrimPrefix(key, "-"), Desc: desc})
+	}
+	return blocks
+}
+
+// GetAllowedOrdering maps the names declared with the `ordering` struct tag
+// to their Go field names.
+func GetAllowedOrdering(model interface{}) map[string]string {
+	val := reflect.TypeOf(model)
+	allowed := map[string]string{}
+
+	if val.Kind() == reflect.Struct {
+		for i := 0; i < val.NumField(); i++ {
+			field := val.Field(i)
+			name := field.Tag.Get("ordering")
+			if name != "" {
+				allowed[name] = field.Name
+			}
+		}
+	}
+
+	return allowed
+}
@@ -0,0 +1,14 @@
+package ordering
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+const QueryParam = "ordering"
+
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("ordering", Parse(c.Query(QueryParam)))
+		c.Next()
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func Middleware() gin.HandlerFunc {
+// Middleware reads the page, limit, cursor and with_count parameters. Limits
+// above maxLimit are lowered to it, so no client can read a whole table in
+// one request; zero leaves them unbounded.
+func Middleware(maxLimit int) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pageStr := c.Query("page")
 		limitStr := c.Query("limit")
@@ -20,10 +23,20 @@ func Middleware() gin.HandlerFunc {
 		if err != nil || limit < 1 {
 			limit = 10 // مقدار پیش‌فرض
 		}
+		if maxLimit > 0 && limit > maxLimit {
+			limit = maxLimit
+		}
+
+		// ?cursor= switches to keyset pagination, left empty for the first page
+		cursor, keyset := c.GetQuery("cursor")
+		withCount, _ := strconv.ParseBool(c.Query("with_count"))
 
 		// ذخیره اطلاعات pagination در context
 		c.Set("page", page)
 		c.Set("limit", limit)
+		c.Set("cursor", cursor)
+		c.Set("keyset", keyset)
+		c.Set("with_count", withCount)
 
 		c.Next() // ادامه به handler بعدی
 	}
@@ -24,6 +24,26 @@ type Response struct {
 	Items      interface{} `json:"data"`
 }
 
+type cursorPagination struct {
+	Next  string `json:"next_cursor"`
+	Prev  string `json:"prev_cursor"`
+	Limit int    `json:"limit"`
+	// only counted when asked for with ?with_count=true
+	Count *int64 `json:"total_records,omitempty"`
+}
+
+type CursorResponse struct {
+	Pagination cursorPagination `json:"pagination"`
+	Items      interface{}      `json:"data"`
+}
+
+func GenerateCursorResponse(limit int, next, prev string, count *int64, items interface{}) CursorResponse {
+	return CursorResponse{
+		Items:      items,
+		Pagination: cursorPagination{Next: next, Prev: prev, Limit: limit, Count: count},
+	}
+}
+
 func GenerateResponse(limit, total int, count int64, ctx *gin.Context, items interface{}) Response {
 	hasPrev, hasNext := Counter(count, total, limit)
 	var next string
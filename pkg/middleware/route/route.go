@@ -0,0 +1,21 @@
+// Package route looks up per-route settings, like timeouts and rate limit
+// policies, for the route gin matched.
+package route
+
+import "github.com/gin-gonic/gin"
+
+// Lookup returns the setting of the route of c and the key it is stored
+// under. Keys are "METHOD /full/path" or just "/full/path" for every method,
+// as registered with gin; the method specific key wins. Requests no route
+// matched find nothing.
+func Lookup[V any](settings map[string]V, c *gin.Context) (V, string, bool) {
+	if path := c.FullPath(); path != "" {
+		for _, key := range []string{c.Request.Method + " " + path, path} {
+			if value, ok := settings[key]; ok {
+				return value, key, true
+			}
+		}
+	}
+	var zero V
+	return zero, "", false
+}
@@ -0,0 +1,47 @@
+package route
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLookup(t *testing.T) {
+	settings := map[string]int{
+		"GET /offer/:id": 1,
+		"/offer/:id":     2,
+		"/health":        3,
+		"":               4,
+		"GET ":           5,
+	}
+	tests := []struct {
+		method, path string
+		want         int
+		wantKey      string
+		wantOK       bool
+	}{
+		{http.MethodGet, "/offer/7", 1, "GET /offer/:id", true},
+		{http.MethodPatch, "/offer/7", 2, "/offer/:id", true},
+		{http.MethodGet, "/health", 3, "/health", true},
+		{http.MethodGet, "/other", 0, "", false},
+		// no route matched, the empty path keys must not apply
+		{http.MethodGet, "/missing", 0, "", false},
+	}
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		var got int
+		var key string
+		var ok bool
+		app := gin.New()
+		app.NoRoute(func(c *gin.Context) { got, key, ok = Lookup(settings, c) })
+		for _, path := range []string{"/offer/:id", "/health", "/other"} {
+			app.Handle(tt.method, path, func(c *gin.Context) { got, key, ok = Lookup(settings, c) })
+		}
+		app.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.path, nil))
+		if got != tt.want || key != tt.wantKey || ok != tt.wantOK {
+			t.Errorf("Lookup(%s %s) = %d, %q, %v, want %d, %q, %v", tt.method, tt.path, got, key, ok, tt.want, tt.wantKey, tt.wantOK)
+		}
+	}
+}
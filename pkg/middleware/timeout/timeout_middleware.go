@@ -0,0 +1,31 @@
+package timeout
+
+import (
+	"context"
+	"ibrokers_service/pkg/middleware/route"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware puts a deadline on the request context, which the repositories
+// pass on to the database. routes overrides defaultTimeout for single
+// routes, keyed as route.Lookup reads them. A zero timeout leaves the
+// request without one.
+func Middleware(defaultTimeout time.Duration, routes map[string]time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		timeout, _, ok := route.Lookup(routes, c)
+		if !ok {
+			timeout = defaultTimeout
+		}
+		if timeout <= 0 {
+			c.Next()
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
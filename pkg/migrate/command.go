@@ -0,0 +1,109 @@
+package migrate
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+	"text/tabwriter"
+
+	"gorm.io/gorm"
+)
+
+const usage = `usage: migrate <command>
+
+  up             apply all pending migrations
+  down [-n N]    revert the last N applied migrations (default 1)
+  status         list migrations and when they were applied
+  baseline N     record the migrations up to version N as applied without
+                 running them, for a database whose schema already matches
+  create NAME    add an empty migration pair to -dir
+`
+
+// Run executes a migrate subcommand. open is only called by the commands
+// that need the database; dir is where create writes new files.
+func Run(args []string, fsys fs.FS, dir string, open func() (*gorm.DB, error), out io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(out, usage)
+		return fmt.Errorf("missing command")
+	}
+
+	flags := flag.NewFlagSet("migrate "+args[0], flag.ContinueOnError)
+	flags.SetOutput(out)
+	steps := flags.Int("n", 1, "number of migrations to revert")
+	flags.StringVar(&dir, "dir", dir, "directory new migrations are written to")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if args[0] == "create" {
+		if flags.NArg() != 1 {
+			return fmt.Errorf("create needs a migration name")
+		}
+		up, down, err := Create(dir, flags.Arg(0), "", "")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "created %s\ncreated %s\n", up, down)
+		return nil
+	}
+
+	db, err := open()
+	if err != nil {
+		return err
+	}
+	migrator, err := New(db, fsys)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		done, err := migrator.Up()
+		for _, migration := range done {
+			fmt.Fprintf(out, "applied %s\n", migration)
+		}
+		if err == nil && len(done) == 0 {
+			fmt.Fprintln(out, "schema is up to date")
+		}
+		return err
+	case "baseline":
+		if flags.NArg() != 1 {
+			return fmt.Errorf("baseline needs a version")
+		}
+		version, err := strconv.ParseInt(flags.Arg(0), 10, 64)
+		if err != nil {
+			return fmt.Errorf("baseline: %q is not a version", flags.Arg(0))
+		}
+		done, err := migrator.Baseline(version)
+		for _, migration := range done {
+			fmt.Fprintf(out, "recorded %s\n", migration)
+		}
+		return err
+	case "down":
+		done, err := migrator.Down(*steps)
+		for _, migration := range done {
+			fmt.Fprintf(out, "reverted %s\n", migration)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			applied := "pending"
+			if status.AppliedAt != nil {
+				applied = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", status.Version, status.Name, applied)
+		}
+		return w.Flush()
+	default:
+		fmt.Fprint(out, usage)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
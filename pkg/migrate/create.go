@@ -0,0 +1,44 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var nonWord = regexp.MustCompile(`\W+`)
+
+// Create writes an up/down pair numbered after the newest migration in dir
+// and returns the paths. Empty SQL gets a placeholder comment.
+func Create(dir, name, upSQL, downSQL string) (up, down string, err error) {
+	name = strings.Trim(nonWord.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" {
+		return "", "", fmt.Errorf("migration name is empty")
+	}
+	migrations, err := Load(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	version := int64(1)
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+
+	base := filepath.Join(dir, Migration{Version: version, Name: name}.String())
+	up, down = base+".up.sql", base+".down.sql"
+	if upSQL == "" {
+		upSQL = fmt.Sprintf("-- %s\n", name)
+	}
+	if downSQL == "" {
+		downSQL = fmt.Sprintf("-- revert %s\n", name)
+	}
+	if err := os.WriteFile(up, []byte(upSQL), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(down, []byte(downSQL), 0o644); err != nil {
+		return "", "", err
+	}
+	return up, down, nil
+}
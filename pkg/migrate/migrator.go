@@ -0,0 +1,198 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// lockKey is the Postgres advisory lock held while migrating, so replicas
+// starting together do not apply the same migration twice.
+const lockKey = 7_261_018
+
+var ErrIrreversible = errors.New("migration has no down file")
+
+// Migrator applies migrations and records them in the schema_migrations
+// table.
+type Migrator struct {
+	DB         *gorm.DB
+	Migrations []Migration
+}
+
+// Status is a migration and when it was applied, nil while pending.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type schemaMigration struct {
+	Version   int64 `gorm:"primaryKey"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+func New(db *gorm.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{DB: db, Migrations: migrations}, nil
+}
+
+func (m *Migrator) Status() ([]Status, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, len(m.Migrations))
+	for i, migration := range m.Migrations {
+		statuses[i].Migration = migration
+		if row, ok := applied[migration.Version]; ok {
+			statuses[i].AppliedAt = &row.AppliedAt
+		}
+	}
+	return statuses, nil
+}
+
+// Pending returns the migrations that are not applied yet, in order.
+func (m *Migrator) Pending() ([]Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, migration := range m.Migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up applies every pending migration, each in its own transaction, and
+// returns the applied ones.
+func (m *Migrator) Up() ([]Migration, error) {
+	var done []Migration
+	err := m.locked(func() error {
+		pending, err := m.Pending()
+		if err != nil {
+			return err
+		}
+		for _, migration := range pending {
+			err := m.DB.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Up).Error; err != nil {
+					return err
+				}
+				return tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", migration, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline records the pending migrations up to version as applied without
+// running them. It adopts a database whose schema was made another way, like
+// by AutoMigrate, once it has been checked to match those migrations.
+func (m *Migrator) Baseline(version int64) ([]Migration, error) {
+	var done []Migration
+	err := m.locked(func() error {
+		if !slices.ContainsFunc(m.Migrations, func(migration Migration) bool { return migration.Version == version }) {
+			return fmt.Errorf("no migration %04d", version)
+		}
+		pending, err := m.Pending()
+		if err != nil {
+			return err
+		}
+		for _, migration := range pending {
+			if migration.Version > version {
+				break
+			}
+			if err := m.DB.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error; err != nil {
+				return fmt.Errorf("%s: %w", migration, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts the last steps applied migrations, newest first.
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	var done []Migration
+	err := m.locked(func() error {
+		statuses, err := m.Status()
+		if err != nil {
+			return err
+		}
+		for i := len(statuses) - 1; i >= 0 && len(done) < steps; i-- {
+			migration := statuses[i].Migration
+			if statuses[i].AppliedAt == nil {
+				continue
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("%s: %w", migration, ErrIrreversible)
+			}
+			err := m.DB.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Down).Error; err != nil {
+					return err
+				}
+				return tx.Delete(&schemaMigration{Version: migration.Version}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", migration, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+func (m *Migrator) applied() (map[int64]schemaMigration, error) {
+	err := m.DB.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint PRIMARY KEY,
+		name text NOT NULL,
+		applied_at timestamp with time zone NOT NULL
+	)`).Error
+	if err != nil {
+		return nil, err
+	}
+	var rows []schemaMigration
+	if err := m.DB.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]schemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// locked runs fn while holding the advisory lock on Postgres. Other
+// databases, used in development only, run fn directly.
+func (m *Migrator) locked(fn func() error) error {
+	if m.DB.Dialector.Name() != "postgres" {
+		return fn()
+	}
+	return m.DB.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", lockKey).Error; err != nil {
+			return err
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", lockKey)
+		return fn()
+	})
+}
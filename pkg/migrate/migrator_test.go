@@ -0,0 +1,90 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func testMigrator(t *testing.T) *Migrator {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+	// sqlite reads "timestamp with time zone" back as text, datetime it parses
+	if err := db.Exec("CREATE TABLE schema_migrations (version integer PRIMARY KEY, name text NOT NULL, applied_at datetime NOT NULL)").Error; err != nil {
+		t.Fatal(err)
+	}
+	return &Migrator{DB: db, Migrations: []Migration{
+		{Version: 1, Name: "brokers", Up: "CREATE TABLE brokers (id integer PRIMARY KEY)", Down: "DROP TABLE brokers"},
+		{Version: 2, Name: "halls", Up: "CREATE TABLE halls (id integer PRIMARY KEY)", Down: "DROP TABLE halls"},
+		{Version: 3, Name: "offers", Up: "CREATE TABLE offers (id integer PRIMARY KEY)", Down: "DROP TABLE offers"},
+	}}
+}
+
+func TestBaseline(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  int64
+		recorded int
+		applied  []string
+		wantErr  bool
+	}{
+		{name: "first", version: 1, recorded: 1, applied: []string{"halls", "offers"}},
+		{name: "middle", version: 2, recorded: 2, applied: []string{"offers"}},
+		{name: "last", version: 3, recorded: 3},
+		{name: "unknown version", version: 4, applied: []string{"brokers", "halls", "offers"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := testMigrator(t)
+			// the tables recorded by the baseline already exist
+			for _, migration := range m.Migrations[:tt.recorded] {
+				if err := m.DB.Exec(migration.Up).Error; err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			recorded, err := m.Baseline(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Baseline(%d) error = %v, want error %v", tt.version, err, tt.wantErr)
+			}
+			if len(recorded) != tt.recorded {
+				t.Fatalf("Baseline(%d) recorded %v, want %d migrations", tt.version, recorded, tt.recorded)
+			}
+
+			// Up fails on an existing table, so it must only run the rest
+			applied, err := m.Up()
+			if err != nil {
+				t.Fatalf("Up() after Baseline(%d) error = %v", tt.version, err)
+			}
+			if len(applied) != len(tt.applied) {
+				t.Fatalf("Up() applied %v, want %v", applied, tt.applied)
+			}
+			for i, migration := range applied {
+				if migration.Name != tt.applied[i] {
+					t.Errorf("Up() applied %v, want %v", applied, tt.applied)
+				}
+			}
+		})
+	}
+}
+
+func TestBaselineKeepsApplied(t *testing.T) {
+	m := testMigrator(t)
+	if _, err := m.Up(); err != nil {
+		t.Fatal(err)
+	}
+	recorded, err := m.Baseline(3)
+	if err != nil || len(recorded) != 0 {
+		t.Errorf("Baseline(3) on an applied schema = %v, %v, want nothing recorded", recorded, err)
+	}
+}
@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time          { return c.t }
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+func newTestMemory() (*Memory, *clock) {
+	c := &clock{t: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	m := NewMemory()
+	m.now = c.now
+	return m, c
+}
+
+func TestMemoryTake(t *testing.T) {
+	policy := Policy{Requests: 60, Per: time.Minute, Burst: 3}
+	steps := []struct {
+		name      string
+		advance   time.Duration
+		allowed   bool
+		remaining int
+		retry     time.Duration
+	}{
+		{"first call of a full bucket", 0, true, 2, 0},
+		{"second", 0, true, 1, 0},
+		{"third empties the burst", 0, true, 0, 0},
+		{"empty bucket", 0, false, 0, time.Second},
+		{"half a token later", 500 * time.Millisecond, false, 0, 500 * time.Millisecond},
+		{"one token later", 500 * time.Millisecond, true, 0, 0},
+		{"refilled after a long pause", time.Hour, true, 2, 0},
+	}
+	m, c := newTestMemory()
+	for _, step := range steps {
+		c.advance(step.advance)
+		r, err := m.Take(context.Background(), "k", policy)
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if r.Allowed != step.allowed || r.Remaining != step.remaining || r.RetryAfter != step.retry || r.Limit != 3 {
+			t.Errorf("%s: got %+v, want allowed %v remaining %d retry %s", step.name, r, step.allowed, step.remaining, step.retry)
+		}
+	}
+}
+
+func TestMemoryKeysAreSeparate(t *testing.T) {
+	m, _ := newTestMemory()
+	policy := Policy{Requests: 1, Per: time.Minute}
+	for _, key := range []string{"a", "b"} {
+		if r, _ := m.Take(context.Background(), key, policy); !r.Allowed {
+			t.Errorf("%s: first call denied", key)
+		}
+	}
+	if r, _ := m.Take(context.Background(), "a", policy); r.Allowed {
+		t.Error("a: second call allowed")
+	}
+}
+
+func TestMemorySweep(t *testing.T) {
+	m, c := newTestMemory()
+	policy := Policy{Requests: 10, Per: 10 * time.Second}
+	m.Take(context.Background(), "idle", policy)
+	c.advance(500 * time.Millisecond)
+	m.Take(context.Background(), "busy", policy)
+
+	// idle is full again at 1s, busy at 1.5s
+	m.sweep(c.t.Add(500 * time.Millisecond))
+	if _, ok := m.buckets["idle"]; ok {
+		t.Error("refilled bucket was kept")
+	}
+	if _, ok := m.buckets["busy"]; !ok {
+		t.Error("bucket still refilling was dropped")
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	tests := []struct {
+		policy    Policy
+		unlimited bool
+		capacity  float64
+		rate      float64
+	}{
+		{Policy{}, true, 0, 0},
+		{Policy{Requests: 60}, true, 60, 0},
+		{Policy{Requests: 60, Per: time.Minute}, false, 60, 1},
+		{Policy{Requests: 60, Per: time.Minute, Burst: 5}, false, 5, 1},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.Unlimited(); got != tt.unlimited {
+			t.Errorf("%+v: Unlimited() = %v, want %v", tt.policy, got, tt.unlimited)
+		}
+		if tt.unlimited {
+			continue
+		}
+		if got := tt.policy.capacity(); got != tt.capacity {
+			t.Errorf("%+v: capacity() = %v, want %v", tt.policy, got, tt.capacity)
+		}
+		if got := tt.policy.rate(); got != tt.rate {
+			t.Errorf("%+v: rate() = %v, want %v", tt.policy, got, tt.rate)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"fmt"
+	"ibrokers_service/pkg/apperror"
+	"ibrokers_service/pkg/auth"
+	"ibrokers_service/pkg/middleware/route"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Headers of draft-ietf-httpapi-ratelimit-headers, sent with every limited
+// response.
+const (
+	HeaderLimit     = "RateLimit-Limit"
+	HeaderRemaining = "RateLimit-Remaining"
+	HeaderReset     = "RateLimit-Reset"
+	HeaderPolicy    = "RateLimit-Policy"
+)
+
+// Limiter gives every client a bucket per route policy. Clients are told
+// apart by the subject of their token or API key, anonymous ones by IP as
+// gin.Context.ClientIP reports it, which believes X-Forwarded-For only from
+// the trusted proxies of the engine.
+type Limiter struct {
+	Store   Store
+	Default Policy
+	// Routes overrides Default for single routes, keyed as route.Lookup
+	// reads them. Routes without a policy of their own share one bucket
+	// under Default.
+	Routes map[string]Policy
+	// FailOpen lets requests through when the store fails, instead of
+	// answering 503.
+	FailOpen bool
+}
+
+// Middleware answers 429 with Retry-After when the client's bucket is
+// empty. Register it after the authentication middleware.
+func (l Limiter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scope, policy := l.policy(c)
+		if policy.Unlimited() {
+			c.Next()
+			return
+		}
+		result, err := l.Store.Take(c.Request.Context(), scope+"|"+client(c), policy)
+		if err != nil {
+			if l.FailOpen {
+				log.Printf("rate limit: %v", err)
+				c.Next()
+				return
+			}
+			apperror.Write(c, apperror.ErrUnavailable.Wrap(fmt.Errorf("rate limit: %w", err)))
+			return
+		}
+
+		c.Header(HeaderLimit, strconv.Itoa(result.Limit))
+		c.Header(HeaderRemaining, strconv.Itoa(result.Remaining))
+		c.Header(HeaderReset, ceilSeconds(result.Reset))
+		c.Header(HeaderPolicy, fmt.Sprintf("%d;w=%d;burst=%d", policy.Requests, int(policy.Per.Seconds()), result.Limit))
+		if !result.Allowed {
+			c.Header("Retry-After", ceilSeconds(result.RetryAfter))
+			apperror.Write(c, apperror.ErrRateLimited.WithDetail("%d requests per %s are allowed, retry in %s", policy.Requests, policy.Per, ceilSeconds(result.RetryAfter)+"s"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// policy returns the policy of the route and the scope naming its buckets.
+func (l Limiter) policy(c *gin.Context) (string, Policy) {
+	if policy, key, ok := route.Lookup(l.Routes, c); ok {
+		return key, policy
+	}
+	return "*", l.Default
+}
+
+func client(c *gin.Context) string {
+	if principal, ok := auth.FromContext(c); ok {
+		return "sub:" + principal.Subject
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
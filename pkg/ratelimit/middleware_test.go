@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"ibrokers_service/pkg/auth"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Policy) (Result, error) {
+	return Result{}, errors.New("down")
+}
+
+func newTestEngine(limiter Limiter) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	app := gin.New()
+	app.Use(func(c *gin.Context) {
+		if sub := c.GetHeader("X-Test-Subject"); sub != "" {
+			auth.SetPrincipal(c, auth.Principal{Subject: sub})
+		}
+	})
+	app.Use(limiter.Middleware())
+	for _, path := range []string{"/offer", "/other", "/healthz"} {
+		app.GET(path, func(c *gin.Context) { c.Status(http.StatusOK) })
+	}
+	return app
+}
+
+func call(app *gin.Engine, path, subject string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	if subject != "" {
+		req.Header.Set("X-Test-Subject", subject)
+	}
+	w := httptest.NewRecorder()
+	app.ServeHTTP(w, req)
+	return w
+}
+
+func TestMiddleware(t *testing.T) {
+	app := newTestEngine(Limiter{
+		Store:   NewMemory(),
+		Default: Policy{Requests: 60, Per: time.Minute, Burst: 2},
+		Routes: map[string]Policy{
+			"GET /offer": {Requests: 1, Per: time.Minute},
+			"/healthz":   {},
+		},
+	})
+	steps := []struct {
+		path, subject string
+		status        int
+		remaining     string
+		policy        string
+	}{
+		{"/offer", "", http.StatusOK, "0", "1;w=60;burst=1"},
+		{"/offer", "", http.StatusTooManyRequests, "0", "1;w=60;burst=1"},
+		{"/offer", "api_key:1", http.StatusOK, "0", "1;w=60;burst=1"},
+		{"/other", "", http.StatusOK, "1", "60;w=60;burst=2"},
+		{"/other", "", http.StatusOK, "0", "60;w=60;burst=2"},
+		{"/other", "", http.StatusTooManyRequests, "0", "60;w=60;burst=2"},
+		{"/healthz", "", http.StatusOK, "", ""},
+		{"/healthz", "", http.StatusOK, "", ""},
+	}
+	for i, step := range steps {
+		w := call(app, step.path, step.subject)
+		if w.Code != step.status {
+			t.Errorf("%d %s: status %d, want %d", i, step.path, w.Code, step.status)
+		}
+		if got := w.Header().Get(HeaderRemaining); got != step.remaining {
+			t.Errorf("%d %s: %s %q, want %q", i, step.path, HeaderRemaining, got, step.remaining)
+		}
+		if got := w.Header().Get(HeaderPolicy); got != step.policy {
+			t.Errorf("%d %s: %s %q, want %q", i, step.path, HeaderPolicy, got, step.policy)
+		}
+		if retry := w.Header().Get("Retry-After"); (step.status == http.StatusTooManyRequests) != (retry != "") {
+			t.Errorf("%d %s: Retry-After %q with status %d", i, step.path, retry, w.Code)
+		}
+	}
+}
+
+func TestMiddlewareStoreFailure(t *testing.T) {
+	tests := []struct {
+		failOpen bool
+		status   int
+	}{
+		{false, http.StatusServiceUnavailable},
+		{true, http.StatusOK},
+	}
+	for _, tt := range tests {
+		app := newTestEngine(Limiter{Store: failingStore{}, Default: Policy{Requests: 1, Per: time.Second}, FailOpen: tt.failOpen})
+		if w := call(app, "/other", ""); w.Code != tt.status {
+			t.Errorf("fail open %v: status %d, want %d", tt.failOpen, w.Code, tt.status)
+		}
+	}
+}
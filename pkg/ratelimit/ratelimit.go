@@ -0,0 +1,71 @@
+// Package ratelimit limits how often each client may call the API, with a
+// token bucket per client and route policy, kept in memory or in Redis when
+// several instances have to share the limits.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Policy lets a client make Requests calls Per period on average, and up to
+// Burst at once. A zero Burst is Requests, zero Requests means no limit.
+type Policy struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+func (p Policy) Unlimited() bool {
+	return p.Requests <= 0 || p.Per <= 0
+}
+
+// capacity is the size of the bucket.
+func (p Policy) capacity() float64 {
+	if p.Burst > 0 {
+		return float64(p.Burst)
+	}
+	return float64(p.Requests)
+}
+
+// rate is the number of tokens added per second.
+func (p Policy) rate() float64 {
+	return float64(p.Requests) / p.Per.Seconds()
+}
+
+// Result is the state of a bucket after taking a token from it.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is the time until the next token, zero when allowed.
+	RetryAfter time.Duration
+}
+
+// newResult describes a bucket left with tokens.
+func newResult(p Policy, allowed bool, tokens float64) Result {
+	rate := p.rate()
+	r := Result{
+		Allowed:   allowed,
+		Limit:     int(p.capacity()),
+		Remaining: int(math.Floor(tokens)),
+		Reset:     seconds((p.capacity() - tokens) / rate),
+	}
+	if !allowed {
+		r.RetryAfter = seconds((1 - tokens) / rate)
+	}
+	return r
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Max(s, 0) * float64(time.Second))
+}
+
+// Store keeps the buckets. Take refills the bucket of key according to p,
+// then takes one token from it if there is one.
+type Store interface {
+	Take(ctx context.Context, key string, p Policy) (Result, error)
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript is the token bucket of Memory.Take run inside Redis, on the
+// server's clock, so every instance shares the bucket and the clocks of the
+// instances do not matter. Buckets expire once they have refilled.
+const takeScript = `
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(state[1]) or capacity
+local updated = tonumber(state[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - updated) * rate)
+local allowed = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', now)
+redis.call('PEXPIRE', KEYS[1], math.max(1, math.ceil((capacity - tokens) / rate)))
+return {allowed, tostring(tokens)}
+`
+
+var takeBucket = redis.NewScript(takeScript)
+
+// Redis keeps the buckets in Redis, or anything speaking its protocol with
+// Lua scripting like Valkey and KeyDB, so all instances share the limits.
+// The client retries calls that failed on a broken connection.
+type Redis struct {
+	Client redis.UniversalClient
+	// Prefix is put in front of every key.
+	Prefix string
+}
+
+func (s *Redis) Take(ctx context.Context, key string, p Policy) (Result, error) {
+	capacity := strconv.FormatFloat(p.capacity(), 'f', -1, 64)
+	perMs := strconv.FormatFloat(p.rate()/1000, 'g', -1, 64)
+	// EVALSHA, and EVAL when the server does not know the script yet
+	reply, err := takeBucket.Run(ctx, s.Client, []string{s.Prefix + key}, capacity, perMs).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("redis: %w", err)
+	}
+	return parseTake(p, reply)
+}
+
+// parseTake reads the {allowed, tokens} reply of takeScript.
+func parseTake(p Policy, reply []any) (Result, error) {
+	if len(reply) != 2 {
+		return Result{}, fmt.Errorf("redis: unexpected reply %v", reply)
+	}
+	allowed, ok := reply[0].(int64)
+	if !ok {
+		return Result{}, fmt.Errorf("redis: unexpected allowed %v", reply[0])
+	}
+	text, _ := reply[1].(string)
+	tokens, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("redis: unexpected tokens %q", text)
+	}
+	return newResult(p, allowed == 1, tokens), nil
+}
+
+// Ping checks that the server answers.
+func (s *Redis) Ping(ctx context.Context) error {
+	return s.Client.Ping(ctx).Err()
+}
+
+func (s *Redis) Close() error {
+	return s.Client.Close()
+}
@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestRedis(t *testing.T) (*Redis, *miniredis.Miniredis) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	server.SetTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	store := &Redis{Client: redis.NewClient(&redis.Options{Addr: server.Addr()}), Prefix: "test:"}
+	t.Cleanup(func() { store.Close() })
+	return store, server
+}
+
+func TestRedisTake(t *testing.T) {
+	store, server := newTestRedis(t)
+	policy := Policy{Requests: 60, Per: time.Minute, Burst: 3}
+	steps := []struct {
+		name      string
+		advance   time.Duration
+		allowed   bool
+		remaining int
+	}{
+		{"first call of a full bucket", 0, true, 2},
+		{"second", 0, true, 1},
+		{"third empties the burst", 0, true, 0},
+		{"empty bucket", 0, false, 0},
+		{"half a token later", 500 * time.Millisecond, false, 0},
+		{"one token later", 500 * time.Millisecond, true, 0},
+		{"refilled after a long pause", time.Hour, true, 2},
+	}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		server.SetTime(now)
+		r, err := store.Take(context.Background(), "k", policy)
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if r.Allowed != step.allowed || r.Remaining != step.remaining || r.Limit != 3 {
+			t.Errorf("%s: got %+v, want allowed %v remaining %d", step.name, r, step.allowed, step.remaining)
+		}
+	}
+}
+
+func TestRedisBucketExpires(t *testing.T) {
+	store, server := newTestRedis(t)
+	policy := Policy{Requests: 1, Per: time.Second}
+	if _, err := store.Take(context.Background(), "k", policy); err != nil {
+		t.Fatal(err)
+	}
+	if !server.Exists("test:k") {
+		t.Fatal("bucket was not stored under the prefix")
+	}
+	if ttl := server.TTL("test:k"); ttl <= 0 || ttl > time.Second {
+		t.Errorf("TTL = %s, want the time until the bucket is full", ttl)
+	}
+	server.FastForward(time.Second)
+	if server.Exists("test:k") {
+		t.Error("refilled bucket was kept")
+	}
+}
+
+// A restarted server closes the pooled connections; the next call must
+// not fail on them.
+func TestRedisReconnects(t *testing.T) {
+	store, server := newTestRedis(t)
+	policy := Policy{Requests: 10, Per: time.Second}
+	if _, err := store.Take(context.Background(), "k", policy); err != nil {
+		t.Fatal(err)
+	}
+	server.Restart()
+	if _, err := store.Take(context.Background(), "k", policy); err != nil {
+		t.Fatalf("after restart: %v", err)
+	}
+}
+
+func TestParseTake(t *testing.T) {
+	policy := Policy{Requests: 2, Per: time.Second}
+	tests := []struct {
+		name    string
+		reply   []any
+		want    Result
+		wantErr bool
+	}{
+		{"allowed", []any{int64(1), "1.5"}, Result{Allowed: true, Limit: 2, Remaining: 1, Reset: 250 * time.Millisecond}, false},
+		{"denied", []any{int64(0), "0.5"}, Result{Limit: 2, Reset: 750 * time.Millisecond, RetryAfter: 250 * time.Millisecond}, false},
+		{"short", []any{int64(1)}, Result{}, true},
+		{"allowed not an integer", []any{"1", "1"}, Result{}, true},
+		{"tokens not a number", []any{int64(1), "x"}, Result{}, true},
+	}
+	for _, tt := range tests {
+		got, err := parseTake(policy, tt.reply)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: err = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package requestid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// GormLogger logs like gorm's default logger, without colors, and puts the
+// request and trace IDs of the query's context in front of every message.
+// It is not a wrapper around logger.Default because gorm would then report
+// this file instead of the caller of the query.
+func GormLogger(writer logger.Writer, config logger.Config) logger.Interface {
+	return &gormLogger{Writer: writer, Config: config}
+}
+
+type gormLogger struct {
+	logger.Writer
+	logger.Config
+}
+
+func (l *gormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	c := *l
+	c.LogLevel = level
+	return &c
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.LogLevel >= logger.Info {
+		l.Printf("%s\n[info] %s"+msg, append([]interface{}{caller(), prefix(ctx)}, data...)...)
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.LogLevel >= logger.Warn {
+		l.Printf("%s\n[warn] %s"+msg, append([]interface{}{caller(), prefix(ctx)}, data...)...)
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.LogLevel >= logger.Error {
+		l.Printf("%s\n[error] %s"+msg, append([]interface{}{caller(), prefix(ctx)}, data...)...)
+	}
+}
+
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.LogLevel <= logger.Silent {
+		return
+	}
+	elapsed := float64(time.Since(begin).Nanoseconds()) / 1e6
+	slow := l.SlowThreshold != 0 && time.Since(begin) > l.SlowThreshold
+
+	var status string
+	switch {
+	case err != nil && l.LogLevel >= logger.Error && (!errors.Is(err, gorm.ErrRecordNotFound) || !l.IgnoreRecordNotFoundError):
+		status = " " + err.Error()
+	case slow && l.LogLevel >= logger.Warn:
+		status = fmt.Sprintf(" SLOW SQL >= %v", l.SlowThreshold)
+	case l.LogLevel == logger.Info:
+	default:
+		return
+	}
+
+	sql, rows := fc()
+	affected := "-"
+	if rows != -1 {
+		affected = strconv.FormatInt(rows, 10)
+	}
+	l.Printf("%s%s\n[%.3fms] [rows:%s] %s%s", caller(), status, elapsed, affected, prefix(ctx), sql)
+}
+
+// ParamsFilter keeps gorm's ParameterizedQueries option working.
+func (l *gormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	if l.ParameterizedQueries {
+		return sql, nil
+	}
+	return sql, params
+}
+
+func prefix(ctx context.Context) string {
+	var fields []string
+	if id := FromContext(ctx); id != "" {
+		fields = append(fields, "request_id="+id)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		fields = append(fields, "trace_id="+sc.TraceID().String())
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(fields, " ") + "] "
+}
+
+// caller is the first frame outside gorm and this file, the code that ran
+// the query.
+func caller() string {
+	pcs := [16]uintptr{}
+	n := runtime.Callers(3, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.File, "gorm.io/") && !strings.HasSuffix(frame.File, "requestid/gorm_logger.go") {
+			return frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Package requestid carries the X-Request-ID of a request through the gin
+// and Go contexts into logs, SQL query logs and outgoing HTTP calls, so
+// everything one client call caused can be found by a single ID.
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const Header = "X-Request-ID"
+
+// ContextKey is the gin context key holding the ID.
+const ContextKey = "request_id"
+
+// maxLength bounds IDs accepted from clients, longer ones are replaced.
+const maxLength = 128
+
+type contextKey struct{}
+
+// Middleware accepts the client's X-Request-ID when it looks sane, or
+// generates one, stores it in the gin and request contexts and echoes it in
+// the response.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(Header)
+		if !valid(id) {
+			id = uuid.NewString()
+		}
+		c.Set(ContextKey, id)
+		c.Request = c.Request.WithContext(NewContext(c.Request.Context(), id))
+		c.Header(Header, id)
+		c.Next()
+	}
+}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the ID stored in ctx, or "" outside of a request.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+func valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Transport adds the ID of the request context to outgoing calls.
+type Transport struct {
+	// Base is used for the actual call, http.DefaultTransport when nil.
+	Base http.RoundTripper
+}
+
+func (t Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	id := FromContext(req.Context())
+	if id == "" || req.Header.Get(Header) != "" {
+		return base.RoundTrip(req)
+	}
+	// a RoundTripper must not modify the request it was given
+	req = req.Clone(req.Context())
+	req.Header.Set(Header, id)
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,120 @@
+// Package tracing sets up OpenTelemetry and opens the spans of the request,
+// service, repository, query and storage layers. Incoming W3C traceparent
+// headers are continued and passed on to outgoing calls, so a slow request
+// can be followed from gin down to the SQL statement or MinIO call.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"ibrokers_service/pkg/apperror"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters, see Options.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+const instrumentation = "ibrokers_service"
+
+// requestIDKey holds the X-Request-ID on server spans, under the name the
+// logs use for it.
+const requestIDKey = attribute.Key("request_id")
+
+// rowsKey holds the rows a statement returned or changed.
+const rowsKey = attribute.Key("db.rows_affected")
+
+type Options struct {
+	// Exporter is none, stdout or otlp. With none no spans are recorded,
+	// but incoming trace context is still passed on.
+	Exporter string
+	// Endpoint is the OTLP/HTTP collector URL, like http://localhost:4318.
+	Endpoint    string
+	ServiceName string
+	Environment string
+	// SampleRatio is the share of new traces recorded; requests arriving
+	// with a sampled traceparent are always recorded.
+	SampleRatio float64
+}
+
+// Setup installs the W3C trace context propagator and the tracer provider
+// for the exporter. The returned function flushes the spans still queued
+// and stops the exporter.
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch opts.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New()
+	case ExporterOTLP:
+		exporter, err = otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(opts.Endpoint))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", opts.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName(opts.ServiceName),
+			semconv.DeploymentEnvironment(opts.Environment),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start opens an internal span below the span of ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentation).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on span and ends it. Errors the client caused, like a
+// missing record or invalid input, are recorded as events only, so the
+// span is not marked failed for them.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		if kind := apperror.KindOf(err); kind == apperror.Internal || kind == apperror.Unavailable || kind == apperror.Timeout {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
+
+// TraceID returns the trace ID of the span in ctx, or "" when there is none,
+// for log lines to link to the trace.
+func TraceID(ctx context.Context) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
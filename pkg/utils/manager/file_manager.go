@@ -2,10 +2,13 @@ package manager
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/minio/minio-go"
+	"go.opentelemetry.io/otel/attribute"
 	"ibrokers_service/pkg/configs"
+	"ibrokers_service/pkg/tracing"
 	"io"
 	"mime/multipart"
 	"os"
@@ -18,11 +21,11 @@ type FileManager struct {
 	PathUtils   PathUtils
 }
 
-func NewFileManager(path string, config configs.Minio) *FileManager {
+func NewFileManager(path, mediaRoot string, config configs.Minio) *FileManager {
 	return &FileManager{
 		Path:        path,
 		MinioClient: config,
-		PathUtils:   PathUtils{Path: path},
+		PathUtils:   PathUtils{BaseDir: path, MediaRoot: mediaRoot},
 	}
 }
 
@@ -37,7 +40,10 @@ func (c *FileManager) IsExist(file *multipart.FileHeader) bool {
 	return false
 }
 
-func (c *FileManager) SaveFile(bucketName string, file *multipart.FileHeader) (string, error) {
+func (c *FileManager) SaveFile(ctx context.Context, bucketName string, file *multipart.FileHeader) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "FileManager.SaveFile", attribute.String("file.name", file.Filename),
+		attribute.Int64("file.size", file.Size))
+	defer func() { tracing.End(span, err) }()
 
 	f, err := file.Open()
 	if err != nil {
@@ -54,7 +60,7 @@ func (c *FileManager) SaveFile(bucketName string, file *multipart.FileHeader) (s
 	if err != nil {
 		return "", err
 	}
-	err = c.MinioClient.UploadFile(bucketName, filename, bytes.NewReader(fileContent),
+	err = c.MinioClient.UploadFile(ctx, bucketName, filename, bytes.NewReader(fileContent),
 		int64(len(fileContent)), minio.PutObjectOptions{ContentType: file.Header.Get("Content-Type")})
 	if err != nil {
 
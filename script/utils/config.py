@@ -1 +0,0 @@
-LOCATION="/home/mobiniqow/Documents/project/ibrokers_service/internal/"